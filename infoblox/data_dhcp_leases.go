@@ -0,0 +1,242 @@
+package infoblox
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+func dataSourceDHCPLeases() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDHCPLeasesRead,
+		Schema: map[string]*schema.Schema{
+			"cidr": {
+				Type:             schema.TypeString,
+				Description:      "Only return leases of this network, in IPv4 Address/CIDR format.",
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsCIDR),
+			},
+			"end_address": {
+				Type:             schema.TypeString,
+				Description:      "The IPv4 Address end address of the range within cidr to return leases from.",
+				Optional:         true,
+				RequiredWith:     []string{"cidr", "start_address"},
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPv4Address),
+			},
+			"hostname": {
+				Type:        schema.TypeString,
+				Description: "Only return leases with this client hostname.",
+				Optional:    true,
+			},
+			"ip_address": {
+				Type:             schema.TypeString,
+				Description:      "Only return leases of this IP address.",
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPAddress),
+			},
+			"leases": {
+				Type:        schema.TypeList,
+				Description: "Leases matching the search criteria.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"binding_state": {
+							Type:        schema.TypeString,
+							Description: "The binding state of the lease, e.g. ACTIVE, FREE or EXPIRED.",
+							Computed:    true,
+						},
+						"cidr": {
+							Type:        schema.TypeString,
+							Description: "The network of the lease in Address/CIDR format.",
+							Computed:    true,
+						},
+						"client_hostname": {
+							Type:        schema.TypeString,
+							Description: "The hostname sent by the client.",
+							Computed:    true,
+						},
+						"end_time": {
+							Type:        schema.TypeString,
+							Description: "The time the lease ends, in RFC 3339 format.",
+							Computed:    true,
+						},
+						"ip_address": {
+							Type:        schema.TypeString,
+							Description: "The leased IP address.",
+							Computed:    true,
+						},
+						"mac_address": {
+							Type:        schema.TypeString,
+							Description: "The MAC address of the client.",
+							Computed:    true,
+						},
+						"network_view": {
+							Type:        schema.TypeString,
+							Description: "The name of the network view in which the lease resides.",
+							Computed:    true,
+						},
+						"ref": {
+							Type:        schema.TypeString,
+							Description: "Reference id of the lease object.",
+							Computed:    true,
+						},
+						"served_by": {
+							Type:        schema.TypeString,
+							Description: "The IP address of the member serving the lease.",
+							Computed:    true,
+						},
+						"server_hostname": {
+							Type:        schema.TypeString,
+							Description: "The hostname of the member serving the lease.",
+							Computed:    true,
+						},
+						"start_time": {
+							Type:        schema.TypeString,
+							Description: "The time the lease starts, in RFC 3339 format.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"mac_address": {
+				Type:             schema.TypeString,
+				Description:      "Only return leases of this MAC address.",
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsMACAddress),
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Description: "The name of the network view in which the leases reside.",
+				Optional:    true,
+			},
+			"query_params": {
+				Type:        schema.TypeMap,
+				Description: "Additional query parameters",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"start_address": {
+				Type:             schema.TypeString,
+				Description:      "The IPv4 Address starting address of the range within cidr to return leases from.",
+				Optional:         true,
+				RequiredWith:     []string{"cidr", "end_address"},
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPv4Address),
+			},
+		},
+	}
+}
+
+// leaseTime formats an epoch lease timestamp, returning an empty string when
+// the lease does not have one
+func leaseTime(epoch *int) string {
+	if epoch == nil {
+		return ""
+	}
+	return time.Unix(int64(*epoch), 0).UTC().Format(time.RFC3339)
+}
+
+// addressInRange reports whether address is an IPv4 address between start and
+// end, inclusive
+func addressInRange(address string, start net.IP, end net.IP) bool {
+	ip := net.ParseIP(address)
+	if ip.To4() == nil {
+		return false
+	}
+	return bytes.Compare(ip.To16(), start.To16()) >= 0 && bytes.Compare(ip.To16(), end.To16()) <= 0
+}
+
+func dataSourceDHCPLeasesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+
+	queryParams := d.Get("query_params").(map[string]interface{})
+	resolvedQueryParams := make(map[string]string)
+
+	for k, v := range queryParams {
+		resolvedQueryParams[k] = v.(string)
+	}
+	if cidr, ok := d.GetOk("cidr"); ok {
+		resolvedQueryParams["network"] = cidr.(string)
+	}
+	if networkView, ok := d.GetOk("network_view"); ok {
+		resolvedQueryParams["network_view"] = networkView.(string)
+	}
+	if ipAddress, ok := d.GetOk("ip_address"); ok {
+		resolvedQueryParams["address"] = ipAddress.(string)
+	}
+	if macAddress, ok := d.GetOk("mac_address"); ok {
+		resolvedQueryParams["hardware"] = strings.ToLower(macAddress.(string))
+	}
+	if hostname, ok := d.GetOk("hostname"); ok {
+		resolvedQueryParams["client_hostname"] = hostname.(string)
+	}
+
+	// Leases can not be searched by address range, so the range is applied to
+	// the leases of its network, which the query is limited to
+	startAddress := net.ParseIP(d.Get("start_address").(string))
+	endAddress := net.ParseIP(d.Get("end_address").(string))
+	filterRange := startAddress != nil && endAddress != nil
+	if filterRange && bytes.Compare(startAddress.To16(), endAddress.To16()) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid range",
+			Detail:   fmt.Sprintf("The range %s-%s ends before it starts", startAddress, endAddress),
+		})
+		return diags
+	}
+	if filterRange {
+		_, network, err := net.ParseCIDR(d.Get("cidr").(string))
+		if err != nil || !network.Contains(startAddress) || !network.Contains(endAddress) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Invalid range",
+				Detail:   fmt.Sprintf("The range %s-%s is not within %s", startAddress, endAddress, d.Get("cidr")),
+			})
+			return diags
+		}
+	}
+
+	id := fmt.Sprintf("%d", schema.HashString(fmt.Sprintf("%s&range=%s-%s", client.BuildQuery(resolvedQueryParams), d.Get("start_address"), d.Get("end_address"))))
+
+	leases, err := client.GetLeasesByQuery(resolvedQueryParams)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	leaseList := []map[string]interface{}{}
+	for _, lease := range leases {
+		if filterRange && !addressInRange(lease.IPAddress, startAddress, endAddress) {
+			continue
+		}
+		leaseList = append(leaseList, map[string]interface{}{
+			"ref":             lease.Ref,
+			"ip_address":      lease.IPAddress,
+			"mac_address":     lease.Mac,
+			"client_hostname": lease.ClientHostname,
+			"binding_state":   lease.BindingState,
+			"start_time":      leaseTime(lease.Starts),
+			"end_time":        leaseTime(lease.Ends),
+			"served_by":       lease.ServedBy,
+			"server_hostname": lease.ServerHostname,
+			"cidr":            lease.CIDR,
+			"network_view":    lease.NetworkView,
+		})
+	}
+
+	d.Set("leases", leaseList)
+	d.SetId(id)
+
+	return diags
+}
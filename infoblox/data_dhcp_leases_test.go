@@ -0,0 +1,105 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUnitDataDHCPLeases(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	for i, address := range []string{"10.80.0.10", "10.80.0.11", "10.80.0.12", "10.80.0.50"} {
+		fake.create("lease", map[string]interface{}{
+			"address":          address,
+			"binding_state":    "ACTIVE",
+			"client_hostname":  fmt.Sprintf("client-%d", i),
+			"hardware":         fmt.Sprintf("00:00:5e:00:53:0%d", i),
+			"network":          "10.80.0.0/24",
+			"network_view":     "default",
+			"served_by":        "10.0.0.2",
+			"server_host_name": "member1.example.com",
+			"starts":           1700000000,
+			"ends":             1700003600,
+		})
+	}
+	fake.create("lease", map[string]interface{}{
+		"address":       "10.81.0.10",
+		"binding_state": "FREE",
+		"hardware":      "00:00:5e:00:53:99",
+		"network":       "10.81.0.0/24",
+		"network_view":  "default",
+	})
+
+	data := dataSourceDHCPLeases()
+	d := schema.TestResourceDataRaw(t, data.Schema, map[string]interface{}{
+		"cidr":         "10.80.0.0/24",
+		"query_params": map[string]interface{}{"_max_results": "2"},
+	})
+	if diags := data.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read: %+v", diags)
+	}
+	if count := d.Get("leases.#").(int); count != 4 {
+		t.Fatalf("expected every page of leases to be returned, found %d", count)
+	}
+	if start := d.Get("leases.0.start_time").(string); start != "2023-11-14T22:13:20Z" {
+		t.Fatalf("unexpected start time %s", start)
+	}
+	if member := d.Get("leases.0.served_by").(string); member != "10.0.0.2" {
+		t.Fatalf("unexpected serving member %s", member)
+	}
+
+	d = schema.TestResourceDataRaw(t, data.Schema, map[string]interface{}{
+		"cidr":          "10.80.0.0/24",
+		"start_address": "10.80.0.11",
+		"end_address":   "10.80.0.20",
+	})
+	if diags := data.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read: %+v", diags)
+	}
+	if count := d.Get("leases.#").(int); count != 2 {
+		t.Fatalf("expected the leases of the range, found %d", count)
+	}
+
+	// A range limits the leases of a network, so it needs the network
+	if diags := data.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"start_address": "10.80.0.11",
+		"end_address":   "10.80.0.20",
+	})); !diags.HasError() {
+		t.Fatal("expected a range without cidr to be rejected")
+	}
+	d = schema.TestResourceDataRaw(t, data.Schema, map[string]interface{}{
+		"cidr":          "10.80.0.0/24",
+		"start_address": "10.81.0.1",
+		"end_address":   "10.81.0.20",
+	})
+	if diags := data.ReadContext(context.Background(), d, client); !diags.HasError() {
+		t.Fatal("expected a range outside of cidr to be rejected")
+	}
+
+	d = schema.TestResourceDataRaw(t, data.Schema, map[string]interface{}{
+		"mac_address": "00:00:5E:00:53:99",
+	})
+	if diags := data.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read: %+v", diags)
+	}
+	if count := d.Get("leases.#").(int); count != 1 || d.Get("leases.0.ip_address").(string) != "10.81.0.10" {
+		t.Fatalf("expected the lease of the MAC address, found %v", d.Get("leases"))
+	}
+	if end := d.Get("leases.0.end_time").(string); end != "" {
+		t.Fatalf("expected no end time for a free lease, found %s", end)
+	}
+
+	d = schema.TestResourceDataRaw(t, data.Schema, map[string]interface{}{
+		"hostname": "client-3",
+	})
+	if diags := data.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read: %+v", diags)
+	}
+	if count := d.Get("leases.#").(int); count != 1 || d.Get("leases.0.ip_address").(string) != "10.80.0.50" {
+		t.Fatalf("expected the lease of the hostname, found %v", d.Get("leases"))
+	}
+}
@@ -0,0 +1,153 @@
+package infoblox
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+func dataSourceDNSView() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDNSViewRead,
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:        schema.TypeString,
+				Description: "Comment for the DNS view; maximum 256 characters.",
+				Computed:    true,
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Description: "Determines whether the DNS view is disabled.",
+				Computed:    true,
+			},
+			"extensible_attributes": {
+				Type:        schema.TypeMap,
+				Description: "Extensible attributes of DNS view (Values are JSON encoded).",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"forward_only": {
+				Type:        schema.TypeBool,
+				Description: "Only send queries to the forwarders instead of falling back to recursion.",
+				Computed:    true,
+			},
+			"forwarders": {
+				Type:        schema.TypeList,
+				Description: "IP addresses of the name servers queries in the view are forwarded to.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"is_default": {
+				Type:        schema.TypeBool,
+				Description: "Whether this is the default DNS view.",
+				Computed:    true,
+			},
+			"match_clients":      dataAddressACSchema("Client addresses the view answers queries for."),
+			"match_destinations": dataAddressACSchema("Destination addresses the view answers queries on."),
+			"name": {
+				Type:          schema.TypeString,
+				Description:   "Name of the DNS view.",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"ref"},
+				AtLeastOneOf:  []string{"name", "ref"},
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Description: "The name of the network view associated with the DNS view.",
+				Computed:    true,
+			},
+			"recursion": {
+				Type:        schema.TypeBool,
+				Description: "Determines whether recursive queries are answered in the view.",
+				Computed:    true,
+			},
+			"ref": {
+				Type:          schema.TypeString,
+				Description:   "Reference id of DNS view object.",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+				AtLeastOneOf:  []string{"name", "ref"},
+			},
+		},
+	}
+}
+
+func dataAddressACSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address": {
+					Type:        schema.TypeString,
+					Description: "IP address, network in Address/CIDR format or Any.",
+					Computed:    true,
+				},
+				"permission": {
+					Type:        schema.TypeString,
+					Description: "ALLOW or DENY.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDNSViewRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	var view infoblox.DNSView
+
+	ref := d.Get("ref").(string)
+	if ref != "" {
+		v, err := client.GetDNSViewByRef(ref, nil)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+		view = v
+	} else {
+		query_params := make(map[string]string)
+		query_params["name"] = d.Get("name").(string)
+		v, err := client.GetDNSViewByQuery(query_params)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+		if len(v) == 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "No results found",
+				Detail:   "The provided name did not match any DNS views",
+			})
+			return diags
+		}
+		if len(v) > 1 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Multiple data results found",
+				Detail:   "The provided name matched multiple DNS views when one was expected",
+			})
+			return diags
+		}
+		view = v[0]
+	}
+
+	check := convertDNSViewToResourceData(client, d, &view)
+	if check.HasError() {
+		return check
+	}
+	d.SetId(view.Ref)
+
+	return diags
+}
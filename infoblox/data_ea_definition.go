@@ -0,0 +1,134 @@
+package infoblox
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+func dataSourceEADefinition() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEADefinitionRead,
+		Schema: map[string]*schema.Schema{
+			"allowed_object_types": {
+				Type:        schema.TypeSet,
+				Description: "The object types this extensible attribute is allowed to associate with.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Description: "Comment for the extensible attribute definition; maximum 256 characters.",
+				Computed:    true,
+			},
+			"default_value": {
+				Type:        schema.TypeString,
+				Description: "Default value used to pre-populate the attribute value in the GUI.",
+				Computed:    true,
+			},
+			"flags": {
+				Type:        schema.TypeString,
+				Description: "Extensible attribute flags.",
+				Computed:    true,
+			},
+			"list_values": {
+				Type:        schema.TypeList,
+				Description: "List of values allowed for an ENUM extensible attribute.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"max": {
+				Type:        schema.TypeInt,
+				Description: "Maximum value of an INTEGER or maximum length of a STRING extensible attribute.",
+				Computed:    true,
+			},
+			"min": {
+				Type:        schema.TypeInt,
+				Description: "Minimum value of an INTEGER or minimum length of a STRING extensible attribute.",
+				Computed:    true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Description:   "Name of the extensible attribute.",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"ref"},
+				AtLeastOneOf:  []string{"name", "ref"},
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Description: "Namespace of the extensible attribute.",
+				Computed:    true,
+			},
+			"ref": {
+				Type:          schema.TypeString,
+				Description:   "Reference id of extensible attribute definition object.",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+				AtLeastOneOf:  []string{"name", "ref"},
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "Type of the extensible attribute.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceEADefinitionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	var definition infoblox.EADefinition
+
+	ref := d.Get("ref").(string)
+	if ref != "" {
+		def, err := client.GetEADefinitionByRef(ref, nil)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+		definition = def
+	} else {
+		query_params := make(map[string]string)
+		query_params["name"] = d.Get("name").(string)
+		defs, err := client.GetEADefinitionByQuery(query_params)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+		if len(defs) == 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "No results found",
+				Detail:   "The provided name did not match any extensible attribute definitions",
+			})
+			return diags
+		}
+		if len(defs) > 1 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Multiple data results found",
+				Detail:   "The provided name matched multiple extensible attribute definitions when one was expected",
+			})
+			return diags
+		}
+		definition = defs[0]
+	}
+
+	check := convertEADefinitionToResourceData(client, d, &definition)
+	if check.HasError() {
+		return check
+	}
+	d.SetId(definition.Ref)
+
+	return diags
+}
@@ -19,6 +19,14 @@ func dataSourceHostRecord() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceHostRecordRead,
 		Schema: map[string]*schema.Schema{
+			"aliases": {
+				Type:        schema.TypeSet,
+				Description: "DNS aliases (CNAMEs) of the host in FQDN format.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"comment": {
 				Type:        schema.TypeString,
 				Description: "Comment for the record; maximum 256 characters.",
@@ -89,6 +97,45 @@ func dataSourceHostRecord() *schema.Resource {
 					},
 				},
 			},
+			"ip_v6_address": {
+				Type:        schema.TypeList,
+				Description: "IPv6 addresses associated with host record.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ref": {
+							Type:        schema.TypeString,
+							Description: "Reference id of address object.",
+							Computed:    true,
+						},
+						"ip_address": {
+							Type:        schema.TypeString,
+							Description: "IPv6 address.",
+							Computed:    true,
+						},
+						"hostname": {
+							Type:        schema.TypeString,
+							Description: "Hostname associated with IP address.",
+							Computed:    true,
+						},
+						"network": {
+							Type:        schema.TypeString,
+							Description: "Network associated with IP address.",
+							Computed:    true,
+						},
+						"duid": {
+							Type:        schema.TypeString,
+							Description: "DHCPv6 Unique Identifier (DUID) associated with IP address.",
+							Computed:    true,
+						},
+						"configure_for_dhcp": {
+							Type:        schema.TypeBool,
+							Description: "Set this to True to enable the DHCPv6 configuration for this host address.",
+							Computed:    true,
+						},
+					},
+				},
+			},
 			"network_view": {
 				Type:        schema.TypeString,
 				Description: "The name of the network view in which the host record resides.",
@@ -111,6 +158,11 @@ func dataSourceHostRecord() *schema.Resource {
 				ConflictsWith: []string{"hostname"},
 				AtLeastOneOf:  dataHostRecordRequiredSearchFields,
 			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Description: "The Time To Live (TTL) value for the record. Unset when the zone TTL is used.",
+				Computed:    true,
+			},
 			"view": {
 				Type:        schema.TypeString,
 				Description: "The name of the DNS view in which the record resides.",
@@ -0,0 +1,234 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+func dataSourceIPAddress() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIPAddressRead,
+		Schema: map[string]*schema.Schema{
+			"cidr": {
+				Type:        schema.TypeString,
+				Description: "The network the IP address belongs to, in IPv4 Address/CIDR format.",
+				Computed:    true,
+			},
+			"conflict_types": {
+				Type:        schema.TypeList,
+				Description: "Types of the conflicts detected for the IP address, when include_discovered_data is set.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"discovered_data": {
+				Type:        schema.TypeList,
+				Description: "Data discovered for the IP address, when include_discovered_data is set.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_model": {
+							Type:        schema.TypeString,
+							Description: "The model of the discovered device.",
+							Computed:    true,
+						},
+						"device_type": {
+							Type:        schema.TypeString,
+							Description: "The type of the discovered device.",
+							Computed:    true,
+						},
+						"device_vendor": {
+							Type:        schema.TypeString,
+							Description: "The vendor of the discovered device.",
+							Computed:    true,
+						},
+						"discoverer": {
+							Type:        schema.TypeString,
+							Description: "The source the data was discovered by.",
+							Computed:    true,
+						},
+						"first_discovered": {
+							Type:        schema.TypeInt,
+							Description: "Epoch timestamp the IP address was first discovered.",
+							Computed:    true,
+						},
+						"last_discovered": {
+							Type:        schema.TypeInt,
+							Description: "Epoch timestamp the IP address was last discovered.",
+							Computed:    true,
+						},
+						"mac_address": {
+							Type:        schema.TypeString,
+							Description: "The discovered MAC address.",
+							Computed:    true,
+						},
+						"netbios_name": {
+							Type:        schema.TypeString,
+							Description: "The discovered NetBIOS name.",
+							Computed:    true,
+						},
+						"os": {
+							Type:        schema.TypeString,
+							Description: "The discovered operating system.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"hostnames": {
+				Type:        schema.TypeList,
+				Description: "List of hostnames associated with IP address.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"include_discovered_data": {
+				Type:        schema.TypeBool,
+				Description: "Also return discovered and conflict data for the IP address.",
+				Optional:    true,
+			},
+			"ip_address": {
+				Type:             schema.TypeString,
+				Description:      "IPv4 address to look up.",
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPv4Address),
+			},
+			"is_conflict": {
+				Type:        schema.TypeBool,
+				Description: "Determines if the IP address has a conflict, when include_discovered_data is set.",
+				Computed:    true,
+			},
+			"mac_address": {
+				Type:        schema.TypeString,
+				Description: "MAC address associated with IP address.",
+				Computed:    true,
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Description: "The name of the network view in which the IP address resides.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"objects": {
+				Type:        schema.TypeList,
+				Description: "Objects associated with IP address.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Description: "Reference id of address object.",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Status of the IP address: USED or UNUSED.",
+				Computed:    true,
+			},
+			"types": {
+				Type:        schema.TypeList,
+				Description: "Types associated with IP address.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"usage": {
+				Type:        schema.TypeList,
+				Description: "Usage associated with IP address.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIPAddressRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+
+	ipAddress := d.Get("ip_address").(string)
+	includeDiscoveredData := d.Get("include_discovered_data").(bool)
+
+	queryParams := map[string]string{
+		"ip_address": ipAddress,
+	}
+	if networkView := d.Get("network_view").(string); networkView != "" {
+		queryParams["network_view"] = networkView
+	}
+
+	addresses, err := client.GetIPv4AddressByQuery(queryParams, includeDiscoveredData)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+	if len(addresses) == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "No results found",
+			Detail:   fmt.Sprintf("The IP address %s is not within any network", ipAddress),
+		})
+		return diags
+	}
+	if len(addresses) > 1 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Multiple data results found",
+			Detail:   fmt.Sprintf("The IP address %s exists in multiple network views, set network_view to select one", ipAddress),
+		})
+		return diags
+	}
+	address := addresses[0]
+
+	d.Set("ref", address.Ref)
+	d.Set("cidr", address.CIDR)
+	d.Set("network_view", address.NetworkView)
+	d.Set("status", address.Status)
+	d.Set("mac_address", address.Mac)
+	d.Set("hostnames", address.Hostnames)
+	d.Set("objects", address.Objects)
+	d.Set("types", address.Types)
+	d.Set("usage", address.Usage)
+	d.Set("is_conflict", address.IsConflict != nil && *address.IsConflict)
+	d.Set("conflict_types", address.ConflictTypes)
+
+	var discoveredData []map[string]interface{}
+	if address.DiscoveredData != nil {
+		discovered := address.DiscoveredData
+		firstDiscovered, lastDiscovered := 0, 0
+		if discovered.FirstDiscovered != nil {
+			firstDiscovered = *discovered.FirstDiscovered
+		}
+		if discovered.LastDiscovered != nil {
+			lastDiscovered = *discovered.LastDiscovered
+		}
+		discoveredData = append(discoveredData, map[string]interface{}{
+			"device_model":     discovered.DeviceModel,
+			"device_type":      discovered.DeviceType,
+			"device_vendor":    discovered.DeviceVendor,
+			"discoverer":       discovered.Discoverer,
+			"first_discovered": firstDiscovered,
+			"last_discovered":  lastDiscovered,
+			"mac_address":      discovered.MacAddress,
+			"netbios_name":     discovered.NetBIOSName,
+			"os":               discovered.OS,
+		})
+	}
+	d.Set("discovered_data", discoveredData)
+
+	d.SetId(address.Ref)
+
+	return diags
+}
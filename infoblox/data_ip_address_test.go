@@ -0,0 +1,116 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+func TestUnitDataIPAddress(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	fake.create("network", map[string]interface{}{
+		"network": "10.70.0.0/24",
+	})
+	fixedAddressRef := fake.create("fixedaddress", map[string]interface{}{
+		"ipv4addr": "10.70.0.10",
+		"mac":      "00:00:5e:00:53:01",
+		"name":     "fixed-address-test",
+	})
+	fake.discover("10.70.0.10", map[string]interface{}{
+		"mac_address":  "00:00:5e:00:53:99",
+		"netbios_name": "ROGUE-HOST",
+		"discoverer":   "Network Discovery",
+	})
+
+	data := dataSourceIPAddress()
+	d := schema.TestResourceDataRaw(t, data.Schema, map[string]interface{}{
+		"ip_address": "10.70.0.10",
+	})
+	if diags := data.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read: %+v", diags)
+	}
+	if status := d.Get("status").(string); status != "USED" {
+		t.Fatalf("expected the fixed address to mark the IP as USED, found %s", status)
+	}
+	if objects := d.Get("objects").([]interface{}); len(objects) != 1 || objects[0] != fixedAddressRef {
+		t.Fatalf("expected the fixed address to own the IP, found %v", objects)
+	}
+	if d.Get("cidr").(string) != "10.70.0.0/24" || d.Get("mac_address").(string) != "00:00:5e:00:53:01" {
+		t.Fatalf("unexpected address %s/%s", d.Get("cidr"), d.Get("mac_address"))
+	}
+	if d.Get("is_conflict").(bool) || d.Get("discovered_data.#").(int) != 0 {
+		t.Fatal("expected discovered data to only be returned when requested")
+	}
+
+	d = schema.TestResourceDataRaw(t, data.Schema, map[string]interface{}{
+		"ip_address":              "10.70.0.10",
+		"include_discovered_data": true,
+	})
+	if diags := data.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read: %+v", diags)
+	}
+	if !d.Get("is_conflict").(bool) || fmt.Sprint(d.Get("conflict_types")) != "[MAC_ADDRESS]" {
+		t.Fatalf("expected a MAC address conflict, found %v/%v", d.Get("is_conflict"), d.Get("conflict_types"))
+	}
+	if name := d.Get("discovered_data.0.netbios_name").(string); name != "ROGUE-HOST" {
+		t.Fatalf("expected the discovered netbios name, found %s", name)
+	}
+
+	d = schema.TestResourceDataRaw(t, data.Schema, map[string]interface{}{
+		"ip_address": "10.70.0.20",
+	})
+	if diags := data.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read: %+v", diags)
+	}
+	if status := d.Get("status").(string); status != "UNUSED" || d.Get("objects.#").(int) != 0 {
+		t.Fatalf("expected an unused address, found %s %v", status, d.Get("objects"))
+	}
+}
+
+func TestUnitClientIPv4AddressMac(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	fake.create("network", map[string]interface{}{
+		"network": "10.71.0.0/24",
+	})
+	fake.create("fixedaddress", map[string]interface{}{
+		"ipv4addr": "10.71.0.10",
+		"mac":      "00:00:5e:00:53:01",
+	})
+
+	// Mac is decoded from and encoded as the ipv4address mac_address field
+	addresses, err := client.GetIPv4AddressByQuery(map[string]string{
+		"ip_address": "10.71.0.10",
+	}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(addresses) != 1 || addresses[0].Mac != "00:00:5e:00:53:01" {
+		t.Fatalf("expected the MAC address of 10.71.0.10, found %+v", addresses)
+	}
+	encoded, err := json.Marshal(addresses[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(encoded), `"mac_address":"00:00:5e:00:53:01"`) {
+		t.Fatalf("expected Mac to be encoded as mac_address, found %s", encoded)
+	}
+
+	// Sequential ranges only return unused addresses, which have no MAC address
+	sequential, err := client.GetSequentialAddressRange(infoblox.AddressQuery{
+		CIDR:  "10.71.0.0/24",
+		Count: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*sequential) != 2 || (*sequential)[0].IPAddress != "10.71.0.1" || (*sequential)[0].Mac != "" {
+		t.Fatalf("unexpected sequential block %+v", *sequential)
+	}
+}
@@ -0,0 +1,418 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+var (
+	dataIPAMStatisticsRequiredFields = []string{
+		"cidr",
+		"ref",
+	}
+)
+
+func dataSourceIPAMStatistics() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIPAMStatisticsRead,
+		Schema: map[string]*schema.Schema{
+			"cidr": {
+				Type:             schema.TypeString,
+				Description:      "The network or network container in IPv4 Address/CIDR format.",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validateIPv4CIDR),
+				AtLeastOneOf:     dataIPAMStatisticsRequiredFields,
+				ConflictsWith:    []string{"ref"},
+			},
+			"conflicts": {
+				Type:        schema.TypeInt,
+				Description: "Number of addresses with a conflict.",
+				Computed:    true,
+			},
+			"end_address": {
+				Type:             schema.TypeString,
+				Description:      "The IPv4 Address end address of the range within cidr.",
+				Optional:         true,
+				Computed:         true,
+				RequiredWith:     []string{"cidr", "start_address"},
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPv4Address),
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Description: "The name of the network view in which the object resides.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"object_type": {
+				Type:        schema.TypeString,
+				Description: "Type of the object the statistics are for: network, networkcontainer or range.",
+				Computed:    true,
+			},
+			"ref": {
+				Type:          schema.TypeString,
+				Description:   "Reference id of the network, network container or range.",
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  dataIPAMStatisticsRequiredFields,
+				ConflictsWith: []string{"cidr"},
+			},
+			"start_address": {
+				Type:             schema.TypeString,
+				Description:      "The IPv4 Address starting address of the range within cidr.",
+				Optional:         true,
+				Computed:         true,
+				RequiredWith:     []string{"cidr", "end_address"},
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPv4Address),
+			},
+			"total": {
+				Type:        schema.TypeInt,
+				Description: "Number of addresses of the object.",
+				Computed:    true,
+			},
+			"unmanaged": {
+				Type:        schema.TypeInt,
+				Description: "Number of discovered addresses that are not managed by an object.",
+				Computed:    true,
+			},
+			"used": {
+				Type:        schema.TypeInt,
+				Description: "Number of used addresses of a network or range, or of the networks within a network container.",
+				Computed:    true,
+			},
+			"utilization": {
+				Type:        schema.TypeFloat,
+				Description: "Percentage of the addresses that are used.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ipamStatisticsTotal returns the number of addresses utilization is measured
+// against: the host addresses of a network or the whole network container
+func ipamStatisticsTotal(objectType string, network *net.IPNet) int {
+	ones, bits := network.Mask.Size()
+	total := 1 << (bits - ones)
+	if objectType == "network" && total > 2 {
+		total -= 2
+	}
+	return total
+}
+
+func readIPAMStatistics(client *infoblox.Client, d *schema.ResourceData, objectType string, ref string, cidr string, networkView string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ip, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+	ones, _ := network.Mask.Size()
+	queryParams := map[string]string{
+		"network": ip.String(),
+	}
+	if networkView != "" {
+		queryParams["network_view"] = networkView
+	}
+	results, err := client.GetIPAMStatisticsByQuery(queryParams)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+	// A network and its container can share a network address
+	var matches []infoblox.IPAMStatistics
+	for _, result := range results {
+		if result.CIDR != nil && *result.CIDR == ones {
+			matches = append(matches, result)
+		}
+	}
+	if len(matches) == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "No results found",
+			Detail:   "The provided cidr did not match any existing network or network container",
+		})
+		return diags
+	}
+	if len(matches) > 1 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Multiple data results found",
+			Detail:   "The provided cidr exists in multiple network views, set network_view to select one",
+		})
+		return diags
+	}
+	statistics := matches[0]
+
+	total := ipamStatisticsTotal(objectType, network)
+	utilization := 0
+	if statistics.Utilization != nil {
+		utilization = *statistics.Utilization
+	}
+	conflicts, unmanaged := 0, 0
+	if statistics.ConflictCount != nil {
+		conflicts = *statistics.ConflictCount
+	}
+	if statistics.UnmanagedCount != nil {
+		unmanaged = *statistics.UnmanagedCount
+	}
+
+	// ipam:statistics only reports utilization, so the used addresses are
+	// counted from the addresses within the network or container
+	var used int
+	if objectType == "network" {
+		used, err = networkUsedAddresses(client, network.String(), statistics.NetworkView)
+	} else {
+		used, err = containerUsedAddresses(client, network.String(), statistics.NetworkView)
+	}
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	d.Set("object_type", objectType)
+	d.Set("ref", ref)
+	d.Set("cidr", network.String())
+	d.Set("network_view", statistics.NetworkView)
+	d.Set("total", total)
+	d.Set("used", used)
+	d.Set("unmanaged", unmanaged)
+	d.Set("conflicts", conflicts)
+	d.Set("utilization", float64(utilization)/10)
+	d.SetId(statistics.Ref)
+
+	return diags
+}
+
+// networkUsedAddresses counts the used host addresses of a network
+func networkUsedAddresses(client *infoblox.Client, cidr string, networkView string) (int, error) {
+	addresses, err := client.GetIPv4AddressByQuery(map[string]string{
+		"network":      cidr,
+		"network_view": networkView,
+		"status":       "USED",
+	}, true)
+	if err != nil {
+		return 0, err
+	}
+	return countUsedAddresses(addresses), nil
+}
+
+// countUsedAddresses counts the used addresses that are not the network or
+// broadcast address of a network
+func countUsedAddresses(addresses []infoblox.IPv4Address) int {
+	used := 0
+	for _, address := range addresses {
+		if !Contains(address.Types, "NETWORK") && !Contains(address.Types, "BROADCAST") {
+			used++
+		}
+	}
+	return used
+}
+
+// containerUsedAddresses counts the used host addresses of the networks within
+// a network container, including those of nested containers. A single paged
+// ipv4address search over the address space of the container is used rather
+// than walking every child network
+func containerUsedAddresses(client *infoblox.Client, cidr string, networkView string) (int, error) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return 0, err
+	}
+	last := make(net.IP, len(network.IP))
+	for i := range network.IP {
+		last[i] = network.IP[i] | ^network.Mask[i]
+	}
+	addresses, err := client.GetIPv4AddressByQuery(map[string]string{
+		"ip_address>":  network.IP.String(),
+		"ip_address<":  last.String(),
+		"network_view": networkView,
+		"status":       "USED",
+	}, true)
+	if err != nil {
+		return 0, err
+	}
+	return countUsedAddresses(addresses), nil
+}
+
+func readRangeStatistics(client *infoblox.Client, d *schema.ResourceData, statistics *infoblox.RangeStatistics) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Range statistics do not include discovery data, so unmanaged and
+	// conflicting addresses are counted from the addresses of the range
+	addresses, err := client.GetIPv4AddressByQuery(map[string]string{
+		"network":      statistics.CIDR,
+		"network_view": statistics.NetworkView,
+		"ip_address>":  statistics.StartAddress,
+		"ip_address<":  statistics.EndAddress,
+		"status":       "USED",
+	}, true)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+	conflicts, unmanaged := 0, 0
+	for _, address := range addresses {
+		if address.IsConflict != nil && *address.IsConflict {
+			conflicts++
+		}
+		if Contains(address.Types, "UNMANAGED") {
+			unmanaged++
+		}
+	}
+
+	total, used, utilization := 0, 0, 0
+	if statistics.TotalHosts != nil {
+		total = *statistics.TotalHosts
+	}
+	if statistics.DynamicHosts != nil {
+		used += *statistics.DynamicHosts
+	}
+	if statistics.StaticHosts != nil {
+		used += *statistics.StaticHosts
+	}
+	if statistics.DHCPUtilization != nil {
+		utilization = *statistics.DHCPUtilization
+	}
+
+	d.Set("object_type", "range")
+	d.Set("ref", statistics.Ref)
+	d.Set("cidr", statistics.CIDR)
+	d.Set("network_view", statistics.NetworkView)
+	d.Set("start_address", statistics.StartAddress)
+	d.Set("end_address", statistics.EndAddress)
+	d.Set("total", total)
+	d.Set("used", used)
+	d.Set("unmanaged", unmanaged)
+	d.Set("conflicts", conflicts)
+	d.Set("utilization", float64(utilization)/10)
+	d.SetId(statistics.Ref)
+
+	return diags
+}
+
+func dataSourceIPAMStatisticsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+
+	ref := d.Get("ref").(string)
+	cidr := d.Get("cidr").(string)
+	networkView := d.Get("network_view").(string)
+
+	switch {
+	case strings.HasPrefix(ref, "range/"):
+		statistics, err := client.GetRangeStatisticsByRef(ref)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+		return readRangeStatistics(client, d, &statistics)
+	case strings.HasPrefix(ref, "networkcontainer/"):
+		container, err := client.GetContainerByRef(ref, nil)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+		return readIPAMStatistics(client, d, "networkcontainer", container.Ref, container.CIDR, container.NetworkView)
+	case strings.HasPrefix(ref, "network/"):
+		network, err := client.GetNetworkByRef(ref, nil)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+		return readIPAMStatistics(client, d, "network", network.Ref, network.CIDR, network.NetworkView)
+	case ref != "":
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unsupported object type",
+			Detail:   fmt.Sprintf("Statistics are only available for network, networkcontainer and range objects, not %s", ref),
+		})
+		return diags
+	}
+
+	if startAddress, ok := d.GetOk("start_address"); ok {
+		queryParams := map[string]string{
+			"network":    cidr,
+			"start_addr": startAddress.(string),
+			"end_addr":   d.Get("end_address").(string),
+		}
+		if networkView != "" {
+			queryParams["network_view"] = networkView
+		}
+		ranges, err := client.GetRangeStatisticsByQuery(queryParams)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+		if len(ranges) == 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "No results found",
+				Detail:   "The provided cidr, start_addr, end_addr did not match any range",
+			})
+			return diags
+		}
+		if len(ranges) > 1 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Multiple data results found",
+				Detail:   "The provided cidr, start_addr, end_addr matched multiple ranges",
+			})
+			return diags
+		}
+		return readRangeStatistics(client, d, &ranges[0])
+	}
+
+	queryParams := map[string]string{
+		"network": cidr,
+	}
+	if networkView != "" {
+		queryParams["network_view"] = networkView
+	}
+	networks, err := client.GetNetworkByQuery(queryParams)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+	if len(networks) == 1 {
+		return readIPAMStatistics(client, d, "network", networks[0].Ref, networks[0].CIDR, networks[0].NetworkView)
+	}
+	if len(networks) == 0 {
+		containerQueryParams := map[string]string{
+			"network": cidr,
+		}
+		if networkView != "" {
+			containerQueryParams["network_view"] = networkView
+		}
+		containers, err := client.GetContainerByQuery(containerQueryParams)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+		if len(containers) == 1 {
+			return readIPAMStatistics(client, d, "networkcontainer", containers[0].Ref, containers[0].CIDR, containers[0].NetworkView)
+		}
+		if len(containers) == 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "No results found",
+				Detail:   "The provided cidr did not match any existing network or network container",
+			})
+			return diags
+		}
+	}
+	diags = append(diags, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "Multiple data results found",
+		Detail:   "The provided cidr exists in multiple network views, set network_view to select one",
+	})
+	return diags
+}
@@ -0,0 +1,126 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUnitDataIPAMStatistics(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	containerRef := fake.create("networkcontainer", map[string]interface{}{
+		"network": "10.90.0.0/22",
+	})
+	networkRef := fake.create("network", map[string]interface{}{
+		"network": "10.90.0.0/24",
+	})
+	rangeRef := fake.create("range", map[string]interface{}{
+		"network":    "10.90.0.0/24",
+		"start_addr": "10.90.0.100",
+		"end_addr":   "10.90.0.109",
+	})
+	for i := 1; i <= 6; i++ {
+		fake.create("fixedaddress", map[string]interface{}{
+			"ipv4addr": fmt.Sprintf("10.90.0.%d", 100+i),
+			"mac":      fmt.Sprintf("00:00:5e:00:53:%02d", i),
+		})
+	}
+	fake.discover("10.90.0.101", map[string]interface{}{
+		"mac_address": "00:00:5e:00:53:99",
+	})
+	fake.discover("10.90.0.200", map[string]interface{}{
+		"mac_address": "00:00:5e:00:53:98",
+	})
+
+	data := dataSourceIPAMStatistics()
+	for _, lookup := range []struct {
+		config      map[string]interface{}
+		objectType  string
+		ref         string
+		total       int
+		used        int
+		unmanaged   int
+		conflicts   int
+		utilization float64
+	}{
+		{map[string]interface{}{"cidr": "10.90.0.0/24"}, "network", networkRef, 254, 7, 1, 1, 2.7},
+		{map[string]interface{}{"ref": networkRef}, "network", networkRef, 254, 7, 1, 1, 2.7},
+		{map[string]interface{}{"cidr": "10.90.0.0/22"}, "networkcontainer", containerRef, 1024, 7, 1, 1, 25},
+		{map[string]interface{}{"ref": rangeRef}, "range", rangeRef, 10, 6, 0, 1, 60},
+		{map[string]interface{}{"cidr": "10.90.0.0/24", "start_address": "10.90.0.100", "end_address": "10.90.0.109"}, "range", rangeRef, 10, 6, 0, 1, 60},
+	} {
+		d := schema.TestResourceDataRaw(t, data.Schema, lookup.config)
+		if diags := data.ReadContext(context.Background(), d, client); diags.HasError() {
+			t.Fatalf("read %v: %+v", lookup.config, diags)
+		}
+		if d.Get("object_type") != lookup.objectType || d.Get("ref") != lookup.ref {
+			t.Fatalf("read %v: expected %s %s, found %s %s", lookup.config, lookup.objectType, lookup.ref, d.Get("object_type"), d.Get("ref"))
+		}
+		found := []interface{}{d.Get("total"), d.Get("used"), d.Get("unmanaged"), d.Get("conflicts"), d.Get("utilization")}
+		expected := []interface{}{lookup.total, lookup.used, lookup.unmanaged, lookup.conflicts, lookup.utilization}
+		if fmt.Sprint(found) != fmt.Sprint(expected) {
+			t.Fatalf("read %v: expected total/used/unmanaged/conflicts/utilization %v, found %v", lookup.config, expected, found)
+		}
+	}
+
+	// Used addresses of a container include those of every child network and
+	// of nested containers, beyond a single page of results
+	fake.create("networkcontainer", map[string]interface{}{
+		"network": "10.95.0.0/16",
+	})
+	for _, cidr := range []string{"10.95.0.0/20", "10.95.32.0/20"} {
+		fake.create("networkcontainer", map[string]interface{}{
+			"network": cidr,
+		})
+	}
+	networks := []string{"10.95.1.0/24", "10.95.2.0/24", "10.95.3.0/24", "10.95.16.0/24", "10.95.17.0/24", "10.95.33.0/24"}
+	for i, cidr := range networks {
+		fake.create("network", map[string]interface{}{
+			"network": cidr,
+		})
+		fake.create("fixedaddress", map[string]interface{}{
+			"ipv4addr": strings.Replace(cidr, "0/24", "10", 1),
+			"mac":      fmt.Sprintf("00:00:5e:00:54:%02d", i),
+		})
+	}
+	fake.create("fixedaddress", map[string]interface{}{
+		"ipv4addr": "10.95.1.11",
+		"mac":      "00:00:5e:00:54:99",
+	})
+	d := schema.TestResourceDataRaw(t, data.Schema, map[string]interface{}{
+		"cidr": "10.95.0.0/16",
+	})
+	if diags := data.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read nested container: %+v", diags)
+	}
+	if used := d.Get("used").(int); used != 7 {
+		t.Fatalf("expected 7 used addresses across the child and nested networks, found %d", used)
+	}
+	d = schema.TestResourceDataRaw(t, data.Schema, map[string]interface{}{
+		"cidr": "10.95.0.0/20",
+	})
+	if diags := data.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read nested container: %+v", diags)
+	}
+	if used := d.Get("used").(int); used != 4 {
+		t.Fatalf("expected 4 used addresses across the networks of the nested container, found %d", used)
+	}
+
+	if diags := data.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"cidr": "2001:db8::/64",
+	})); !diags.HasError() {
+		t.Fatal("expected an IPv6 cidr to be rejected")
+	}
+
+	d = schema.TestResourceDataRaw(t, data.Schema, map[string]interface{}{
+		"cidr": "10.91.0.0/24",
+	})
+	if diags := data.ReadContext(context.Background(), d, client); !diags.HasError() {
+		t.Fatal("expected an unknown network to return no results")
+	}
+}
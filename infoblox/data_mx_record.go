@@ -0,0 +1,177 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+var (
+	dataMXRecordRequiredSearchFields = []string{
+		"name",
+		"dns_name",
+		"ref",
+	}
+)
+
+func dataSourceMXRecord() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceMXRecordRead,
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:        schema.TypeString,
+				Description: "Comment for the record; maximum 256 characters.",
+				Computed:    true,
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Description: "Determines if the record is disabled or not. False means that the record is enabled.",
+				Computed:    true,
+			},
+			"dns_mail_exchanger": {
+				Type:        schema.TypeString,
+				Description: "The mail exchanger in punycode format.",
+				Computed:    true,
+			},
+			"dns_name": {
+				Type:          schema.TypeString,
+				Description:   "The name for an MX record in punycode format.",
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  dataMXRecordRequiredSearchFields,
+				ConflictsWith: remove(dataMXRecordRequiredSearchFields, "dns_name", true),
+			},
+			"extensible_attributes": {
+				Type:        schema.TypeMap,
+				Description: "Extensible attributes of MX record (Values are JSON encoded).",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"mail_exchanger": {
+				Type:        schema.TypeString,
+				Description: "Mail exchanger name in FQDN format.  Narrows the search when a domain has multiple MX records.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Description:   "Name of the domain the MX record serves in FQDN format.",
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  dataMXRecordRequiredSearchFields,
+				ConflictsWith: remove(dataMXRecordRequiredSearchFields, "name", true),
+			},
+			"preference": {
+				Type:        schema.TypeInt,
+				Description: "Preference value; lower values are preferred.",
+				Computed:    true,
+			},
+			"query_params": {
+				Type:        schema.TypeMap,
+				Description: "Additional query parameters",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ref": {
+				Type:          schema.TypeString,
+				Description:   "Reference id of MX record object.",
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  dataMXRecordRequiredSearchFields,
+				ConflictsWith: remove(dataMXRecordRequiredSearchFields, "ref", true),
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Description: "The Time To Live (TTL) value for the record.",
+				Computed:    true,
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Description: "The name of the DNS view in which the record resides.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Description: "The name of the zone in which the record resides.",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceMXRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	var record infoblox.MXRecord
+
+	if ref, ok := d.GetOk("ref"); ok {
+		r, err := client.GetMXRecordByRef(ref.(string), nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		record = r
+	} else {
+		queryParams := d.Get("query_params").(map[string]interface{})
+		resolvedQueryParams := make(map[string]string)
+
+		for k, v := range queryParams {
+			resolvedQueryParams[k] = v.(string)
+		}
+		if zone, ok := d.GetOk("zone"); ok {
+			resolvedQueryParams["zone"] = zone.(string)
+		}
+		if view, ok := d.GetOk("view"); ok {
+			resolvedQueryParams["view"] = view.(string)
+		}
+		if mailExchanger, ok := d.GetOk("mail_exchanger"); ok {
+			resolvedQueryParams["mail_exchanger"] = mailExchanger.(string)
+		}
+		searchField := "name"
+		if name, ok := d.GetOk("name"); ok {
+			resolvedQueryParams["name"] = name.(string)
+		} else if dnsName, ok := d.GetOk("dns_name"); ok {
+			searchField = "DNS name"
+			resolvedQueryParams["dns_name"] = dnsName.(string)
+		}
+		r, err := client.GetMXRecordByQuery(resolvedQueryParams)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if r == nil || len(r) == 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "No results found",
+				Detail:   fmt.Sprintf("The provided %s did not match any MX records", searchField),
+			})
+			return diags
+		}
+		if len(r) > 1 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Multiple data results found",
+				Detail:   fmt.Sprintf("The provided %s matched multiple MX records when one was expected; set mail_exchanger to narrow the search", searchField),
+			})
+			return diags
+		}
+		record = r[0]
+	}
+
+	check := convertMXRecordToResourceData(client, d, &record)
+	if check.HasError() {
+		return check
+	}
+	d.SetId(record.Ref)
+
+	return diags
+}
@@ -0,0 +1,101 @@
+package infoblox
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+func dataSourceNetworkView() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkViewRead,
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:        schema.TypeString,
+				Description: "Comment for the network view; maximum 256 characters.",
+				Computed:    true,
+			},
+			"extensible_attributes": {
+				Type:        schema.TypeMap,
+				Description: "Extensible attributes of network view (Values are JSON encoded).",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"is_default": {
+				Type:        schema.TypeBool,
+				Description: "Whether this is the default network view.",
+				Computed:    true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Description:   "Name of the network view.",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"ref"},
+				AtLeastOneOf:  []string{"name", "ref"},
+			},
+			"ref": {
+				Type:          schema.TypeString,
+				Description:   "Reference id of network view object.",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+				AtLeastOneOf:  []string{"name", "ref"},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkViewRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	var view infoblox.NetworkView
+
+	ref := d.Get("ref").(string)
+	if ref != "" {
+		v, err := client.GetNetworkViewByRef(ref, nil)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+		view = v
+	} else {
+		query_params := make(map[string]string)
+		query_params["name"] = d.Get("name").(string)
+		v, err := client.GetNetworkViewByQuery(query_params)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+		if len(v) == 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "No results found",
+				Detail:   "The provided name did not match any network views",
+			})
+			return diags
+		}
+		if len(v) > 1 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Multiple data results found",
+				Detail:   "The provided name matched multiple network views when one was expected",
+			})
+			return diags
+		}
+		view = v[0]
+	}
+
+	check := convertNetworkViewToResourceData(client, d, &view)
+	if check.HasError() {
+		return check
+	}
+	d.SetId(view.Ref)
+
+	return diags
+}
@@ -0,0 +1,162 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+func dataSourceNetworks() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworksRead,
+		Schema: map[string]*schema.Schema{
+			"comment_regex": {
+				Type:             schema.TypeString,
+				Description:      "Regular expression the network comment must match.",
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsValidRegExp),
+			},
+			"container": {
+				Type:             schema.TypeString,
+				Description:      "CIDR of the network container the networks are directly allocated from.",
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsCIDR),
+			},
+			"dhcp_enabled": {
+				Type:        schema.TypeBool,
+				Description: "When set, only return networks with DHCP enabled (true) or disabled (false).",
+				Optional:    true,
+			},
+			"ea_search": {
+				Type:        schema.TypeMap,
+				Description: "Extensible attribute values the networks must match, keyed by `*` prefixed attribute name.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Description: "The name of the network view to search.",
+				Optional:    true,
+			},
+			"networks": {
+				Type:        schema.TypeList,
+				Description: "Networks matching the search criteria.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:        schema.TypeString,
+							Description: "The network address in IPv4 Address/CIDR format.",
+							Computed:    true,
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Description: "Comment for the network.",
+							Computed:    true,
+						},
+						"disable_dhcp": {
+							Type:        schema.TypeBool,
+							Description: "Disable for DHCP.",
+							Computed:    true,
+						},
+						"extensible_attributes": {
+							Type:        schema.TypeMap,
+							Description: "Extensible attributes of network (Values are JSON encoded).",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"network_view": {
+							Type:        schema.TypeString,
+							Description: "Network view",
+							Computed:    true,
+						},
+						"ref": {
+							Type:        schema.TypeString,
+							Description: "Reference id of network object.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"query_params": {
+				Type:        schema.TypeMap,
+				Description: "Additional query parameters",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworksRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+
+	queryParams := d.Get("query_params").(map[string]interface{})
+	resolvedQueryParams := make(map[string]string)
+
+	for k, v := range queryParams {
+		resolvedQueryParams[k] = v.(string)
+	}
+	for k, v := range d.Get("ea_search").(map[string]interface{}) {
+		resolvedQueryParams[k] = v.(string)
+	}
+	if networkView := d.Get("network_view").(string); networkView != "" {
+		resolvedQueryParams["network_view"] = networkView
+	}
+	if container := d.Get("container").(string); container != "" {
+		resolvedQueryParams["network_container"] = container
+	}
+	if commentRegex := d.Get("comment_regex").(string); commentRegex != "" {
+		resolvedQueryParams["comment~"] = commentRegex
+	}
+	// The raw configuration tells an explicit false apart from unset
+	if config := d.GetRawConfig(); !config.IsNull() && !config.GetAttr("dhcp_enabled").IsNull() {
+		resolvedQueryParams["disable"] = strconv.FormatBool(!d.Get("dhcp_enabled").(bool))
+	}
+
+	id := fmt.Sprintf("%d", schema.HashString(client.BuildQuery(resolvedQueryParams)))
+
+	networks, err := client.GetNetworksByQuery(resolvedQueryParams)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	networkList := []map[string]interface{}{}
+	for _, network := range networks {
+		eas := map[string]string{}
+		if network.ExtensibleAttributes != nil {
+			eas, err = client.ConvertEAsToJSONString(*network.ExtensibleAttributes)
+			if err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+				return diags
+			}
+		}
+		networkList = append(networkList, map[string]interface{}{
+			"ref":                   network.Ref,
+			"cidr":                  network.CIDR,
+			"comment":               network.Comment,
+			"disable_dhcp":          network.DisableDHCP != nil && *network.DisableDHCP,
+			"network_view":          network.NetworkView,
+			"extensible_attributes": eas,
+		})
+	}
+
+	d.Set("networks", networkList)
+	d.SetId(id)
+
+	return diags
+}
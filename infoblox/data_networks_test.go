@@ -0,0 +1,74 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestUnitDataNetworksSearch(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	fake.create("networkcontainer", map[string]interface{}{
+		"network": "10.60.0.0/16",
+	})
+	for i := 0; i < 5; i++ {
+		fake.create("network", map[string]interface{}{
+			"network": fmt.Sprintf("10.60.%d.0/24", i),
+			"comment": fmt.Sprintf("site-a network %d", i),
+			"disable": i == 4,
+			"extattrs": map[string]interface{}{
+				"Site": map[string]interface{}{"value": "site-a"},
+			},
+		})
+	}
+	fake.create("network", map[string]interface{}{
+		"network": "10.61.0.0/24",
+		"comment": "site-b network",
+		"extattrs": map[string]interface{}{
+			"Site": map[string]interface{}{"value": "site-b"},
+		},
+	})
+
+	data := dataSourceNetworks()
+	for _, search := range []struct {
+		config   map[string]interface{}
+		expected int
+	}{
+		{map[string]interface{}{"ea_search": map[string]interface{}{"*Site": "site-a"}}, 5},
+		{map[string]interface{}{"container": "10.60.0.0/16"}, 5},
+		{map[string]interface{}{"comment_regex": "^site-b"}, 1},
+		{map[string]interface{}{"ea_search": map[string]interface{}{"*Site": "site-a"}, "dhcp_enabled": false}, 1},
+		{map[string]interface{}{"ea_search": map[string]interface{}{"*Site": "site-a"}, "dhcp_enabled": true}, 4},
+		{map[string]interface{}{"network_view": "default"}, 6},
+		{map[string]interface{}{"ea_search": map[string]interface{}{"*Site": "site-c"}}, 0},
+	} {
+		// A small page size makes every search span several result pages
+		search.config["query_params"] = map[string]interface{}{"_max_results": "2"}
+		d := testUnitPlanCreate(t, data, search.config, client)
+		if diags := data.ReadContext(context.Background(), d, client); diags.HasError() {
+			t.Fatalf("read %v: %+v", search.config, diags)
+		}
+		if count := d.Get("networks.#").(int); count != search.expected {
+			t.Fatalf("read %v: expected %d networks, found %d", search.config, search.expected, count)
+		}
+		if d.Id() == "" {
+			t.Fatalf("read %v: expected an id to be set", search.config)
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, data.Schema, map[string]interface{}{
+		"comment_regex": "^site-b",
+	})
+	if diags := data.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read: %+v", diags)
+	}
+	if cidr := d.Get("networks.0.cidr").(string); cidr != "10.61.0.0/24" {
+		t.Fatalf("expected 10.61.0.0/24, found %s", cidr)
+	}
+	if ea := d.Get("networks.0.extensible_attributes.Site").(string); ea != `{"value":"site-b","type":"STRING"}` {
+		t.Fatalf("expected the Site extensible attribute, found %s", ea)
+	}
+}
@@ -0,0 +1,167 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/techBeck03/go-ipmath"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+const (
+	// maxExcludeRangeSize bounds the number of addresses a single exclude range
+	// expands to
+	maxExcludeRangeSize = 1024
+)
+
+func dataSourceNextAvailableIPs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNextAvailableIPsRead,
+		Schema: map[string]*schema.Schema{
+			"address_count": {
+				Type:             schema.TypeInt,
+				Description:      "Number of IPs to return.",
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 1000)),
+			},
+			"cidr": {
+				Type:             schema.TypeString,
+				Description:      "Network to return IPs from, in IPv4 Address/CIDR format.",
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsCIDR),
+			},
+			"exclude": {
+				Type:        schema.TypeList,
+				Description: "IPv4 addresses, or ranges of addresses in start-end format, that must not be returned.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ip_addresses": {
+				Type:        schema.TypeList,
+				Description: "The next available IPv4 addresses of the network.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Description: "The name of the network view in which the network resides.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Description: "Reference id of network object.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// expandAddressList expands a list of addresses and start-end address ranges
+// into the individual addresses it covers
+func expandAddressList(list []interface{}) ([]string, error) {
+	var addresses []string
+	for _, item := range list {
+		bounds := strings.SplitN(item.(string), "-", 2)
+		start := net.ParseIP(strings.TrimSpace(bounds[0]))
+		if start.To4() == nil {
+			return nil, fmt.Errorf("%s is not a valid IPv4 address or range", item)
+		}
+		if len(bounds) == 1 {
+			addresses = append(addresses, start.String())
+			continue
+		}
+		end := net.ParseIP(strings.TrimSpace(bounds[1]))
+		if end.To4() == nil {
+			return nil, fmt.Errorf("%s is not a valid IPv4 address or range", item)
+		}
+		ip := ipmath.IP{
+			Address: start,
+		}
+		if ip.GT(end) {
+			return nil, fmt.Errorf("range %s ends before it starts", item)
+		}
+		if ip.Difference(end) >= maxExcludeRangeSize {
+			return nil, fmt.Errorf("range %s is larger than %d addresses", item, maxExcludeRangeSize)
+		}
+		for {
+			addresses = append(addresses, ip.ToIPString())
+			if ip.EQ(end) {
+				break
+			}
+			if err := ip.Inc(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return addresses, nil
+}
+
+func dataSourceNextAvailableIPsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+
+	cidr := d.Get("cidr").(string)
+	count := d.Get("address_count").(int)
+
+	exclude, err := expandAddressList(d.Get("exclude").([]interface{}))
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	queryParams := map[string]string{
+		"network": cidr,
+	}
+	if networkView := d.Get("network_view").(string); networkView != "" {
+		queryParams["network_view"] = networkView
+	}
+
+	id := fmt.Sprintf("%d", schema.HashString(fmt.Sprintf("%s&count=%d&exclude=%s", client.BuildQuery(queryParams), count, strings.Join(exclude, ","))))
+
+	networks, err := client.GetNetworkByQuery(queryParams)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+	if len(networks) == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "No results found",
+			Detail:   "The provided network did not match any existing networks",
+		})
+		return diags
+	}
+	if len(networks) > 1 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Multiple data results found",
+			Detail:   "The provided network exists in multiple network views, set network_view to select one",
+		})
+		return diags
+	}
+	network := networks[0]
+
+	ips, err := client.GetNextAvailableIPs(network.Ref, count, exclude)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	d.Set("ref", network.Ref)
+	d.Set("network_view", network.NetworkView)
+	d.Set("ip_addresses", ips)
+	d.SetId(id)
+
+	return diags
+}
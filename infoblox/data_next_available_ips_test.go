@@ -0,0 +1,67 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestUnitDataNextAvailableIPs(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	fake.create("networkview", map[string]interface{}{
+		"name": "lab",
+	})
+	networkRef := fake.create("network", map[string]interface{}{
+		"network":      "10.80.0.0/24",
+		"network_view": "lab",
+	})
+	fake.create("fixedaddress", map[string]interface{}{
+		"ipv4addr":     "10.80.0.12",
+		"network_view": "lab",
+	})
+
+	data := dataSourceNextAvailableIPs()
+	config := map[string]interface{}{
+		"cidr":          "10.80.0.0/24",
+		"network_view":  "lab",
+		"address_count": 3,
+		"exclude":       []interface{}{"10.80.0.1-10.80.0.10", "10.80.0.13"},
+	}
+	d := schema.TestResourceDataRaw(t, data.Schema, config)
+	if diags := data.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read: %+v", diags)
+	}
+	if ips := fmt.Sprint(d.Get("ip_addresses")); ips != "[10.80.0.11 10.80.0.14 10.80.0.15]" {
+		t.Fatalf("expected free addresses outside the exclusions, found %s", ips)
+	}
+	if ref := d.Get("ref").(string); ref != networkRef {
+		t.Fatalf("expected network %s, found %s", networkRef, ref)
+	}
+
+	// The id only depends on the inputs
+	id := d.Id()
+	d = schema.TestResourceDataRaw(t, data.Schema, config)
+	if diags := data.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read: %+v", diags)
+	}
+	if d.Id() != id {
+		t.Fatalf("expected a stable id %s, found %s", id, d.Id())
+	}
+	config["address_count"] = 4
+	d = schema.TestResourceDataRaw(t, data.Schema, config)
+	if diags := data.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read: %+v", diags)
+	}
+	if d.Id() == id {
+		t.Fatal("expected the id to change with the address count")
+	}
+
+	config["exclude"] = []interface{}{"10.80.0.10-10.80.0.1"}
+	d = schema.TestResourceDataRaw(t, data.Schema, config)
+	if diags := data.ReadContext(context.Background(), d, client); !diags.HasError() {
+		t.Fatal("expected a reversed exclude range to be rejected")
+	}
+}
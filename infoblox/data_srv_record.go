@@ -0,0 +1,187 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+var (
+	dataSRVRecordRequiredSearchFields = []string{
+		"name",
+		"dns_name",
+		"ref",
+	}
+)
+
+func dataSourceSRVRecord() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSRVRecordRead,
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:        schema.TypeString,
+				Description: "Comment for the record; maximum 256 characters.",
+				Computed:    true,
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Description: "Determines if the record is disabled or not. False means that the record is enabled.",
+				Computed:    true,
+			},
+			"dns_name": {
+				Type:          schema.TypeString,
+				Description:   "The name for an SRV record in punycode format.",
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  dataSRVRecordRequiredSearchFields,
+				ConflictsWith: remove(dataSRVRecordRequiredSearchFields, "dns_name", true),
+			},
+			"dns_target": {
+				Type:        schema.TypeString,
+				Description: "The target host in punycode format.",
+				Computed:    true,
+			},
+			"extensible_attributes": {
+				Type:        schema.TypeMap,
+				Description: "Extensible attributes of SRV record (Values are JSON encoded).",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Description:   "Name for the SRV record in _service._proto.domain format.",
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  dataSRVRecordRequiredSearchFields,
+				ConflictsWith: remove(dataSRVRecordRequiredSearchFields, "name", true),
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Description: "Port on which the service is found.",
+				Computed:    true,
+			},
+			"priority": {
+				Type:        schema.TypeInt,
+				Description: "Priority of the target host; lower values are preferred.",
+				Computed:    true,
+			},
+			"query_params": {
+				Type:        schema.TypeMap,
+				Description: "Additional query parameters",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ref": {
+				Type:          schema.TypeString,
+				Description:   "Reference id of SRV record object.",
+				Optional:      true,
+				Computed:      true,
+				AtLeastOneOf:  dataSRVRecordRequiredSearchFields,
+				ConflictsWith: remove(dataSRVRecordRequiredSearchFields, "ref", true),
+			},
+			"target": {
+				Type:        schema.TypeString,
+				Description: "Target host providing the service in FQDN format.  Narrows the search when a service has multiple SRV records.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Description: "The Time To Live (TTL) value for the record.",
+				Computed:    true,
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Description: "The name of the DNS view in which the record resides.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"weight": {
+				Type:        schema.TypeInt,
+				Description: "Relative weight of targets with the same priority.",
+				Computed:    true,
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Description: "The name of the zone in which the record resides.",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceSRVRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+	var record infoblox.SRVRecord
+
+	if ref, ok := d.GetOk("ref"); ok {
+		r, err := client.GetSRVRecordByRef(ref.(string), nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		record = r
+	} else {
+		queryParams := d.Get("query_params").(map[string]interface{})
+		resolvedQueryParams := make(map[string]string)
+
+		for k, v := range queryParams {
+			resolvedQueryParams[k] = v.(string)
+		}
+		if zone, ok := d.GetOk("zone"); ok {
+			resolvedQueryParams["zone"] = zone.(string)
+		}
+		if view, ok := d.GetOk("view"); ok {
+			resolvedQueryParams["view"] = view.(string)
+		}
+		if target, ok := d.GetOk("target"); ok {
+			resolvedQueryParams["target"] = target.(string)
+		}
+		searchField := "name"
+		if name, ok := d.GetOk("name"); ok {
+			resolvedQueryParams["name"] = name.(string)
+		} else if dnsName, ok := d.GetOk("dns_name"); ok {
+			searchField = "DNS name"
+			resolvedQueryParams["dns_name"] = dnsName.(string)
+		}
+		r, err := client.GetSRVRecordByQuery(resolvedQueryParams)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if r == nil || len(r) == 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "No results found",
+				Detail:   fmt.Sprintf("The provided %s did not match any SRV records", searchField),
+			})
+			return diags
+		}
+		if len(r) > 1 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Multiple data results found",
+				Detail:   fmt.Sprintf("The provided %s matched multiple SRV records when one was expected; set target to narrow the search", searchField),
+			})
+			return diags
+		}
+		record = r[0]
+	}
+
+	check := convertSRVRecordToResourceData(client, d, &record)
+	if check.HasError() {
+		return check
+	}
+	d.SetId(record.Ref)
+
+	return diags
+}
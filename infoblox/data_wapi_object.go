@@ -0,0 +1,124 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+func dataSourceWAPIObject() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWAPIObjectRead,
+		Schema: map[string]*schema.Schema{
+			"max_results": {
+				Type:             schema.TypeInt,
+				Description:      "Maximum number of objects returned, or the page size when paging is set.",
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			},
+			"object_type": {
+				Type:             schema.TypeString,
+				Description:      "The WAPI object type to search, e.g. zone_rp or dtc:pool.",
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringDoesNotContainAny("/?&")),
+			},
+			"paging": {
+				Type:        schema.TypeBool,
+				Description: "Follow result pages until every matching object is returned.",
+				Optional:    true,
+				Default:     true,
+			},
+			"refs": {
+				Type:        schema.TypeList,
+				Description: "Reference ids of the matching objects.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"results_json": {
+				Type:        schema.TypeString,
+				Description: "The matching objects as a JSON encoded list.",
+				Computed:    true,
+			},
+			"return_fields": {
+				Type:        schema.TypeList,
+				Description: "Fields returned for each object. The object type's default fields are returned when not set.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"search_fields": {
+				Type:        schema.TypeMap,
+				Description: "Search arguments, keyed by field name with an optional modifier (e.g. `name~` or `*Site`).",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceWAPIObjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+
+	objectType := d.Get("object_type").(string)
+	paging := d.Get("paging").(bool)
+
+	queryParams := make(map[string]string)
+	for k, v := range d.Get("search_fields").(map[string]interface{}) {
+		queryParams[k] = v.(string)
+	}
+	if returnFields := d.Get("return_fields").([]interface{}); len(returnFields) > 0 {
+		var fields []string
+		for _, field := range returnFields {
+			fields = append(fields, field.(string))
+		}
+		queryParams["_return_fields"] = strings.Join(fields, ",")
+	}
+	if maxResults, ok := d.GetOk("max_results"); ok {
+		// A positive limit makes WAPI fail an unpaged search with more
+		// results, while a negative one truncates them
+		if !paging {
+			maxResults = -maxResults.(int)
+		}
+		queryParams["_max_results"] = strconv.Itoa(maxResults.(int))
+	}
+
+	id := fmt.Sprintf("%d", schema.HashString(fmt.Sprintf("%s?%s&paging=%t", objectType, client.BuildQuery(queryParams), paging)))
+
+	objects, err := client.GetWAPIObjectsByQuery(objectType, queryParams, paging)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	refs := []string{}
+	for _, object := range objects {
+		if ref, ok := object["_ref"].(string); ok {
+			refs = append(refs, ref)
+		}
+	}
+	results, err := json.Marshal(objects)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	d.Set("refs", refs)
+	d.Set("results_json", string(results))
+	d.SetId(id)
+
+	return diags
+}
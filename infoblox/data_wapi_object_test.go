@@ -0,0 +1,68 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestUnitDataWAPIObject(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	for i := 0; i < 3; i++ {
+		fake.create("zone_rp", map[string]interface{}{
+			"fqdn":    fmt.Sprintf("rpz%d.example.com", i),
+			"name":    fmt.Sprintf("rpz%d", i),
+			"view":    "default",
+			"comment": "response policy zone",
+			"ttl":     3600,
+		})
+	}
+	fake.create("zone_rp", map[string]interface{}{
+		"fqdn": "blocklist.example.com",
+		"name": "blocklist",
+		"view": "default",
+	})
+
+	data := dataSourceWAPIObject()
+	d := schema.TestResourceDataRaw(t, data.Schema, map[string]interface{}{
+		"object_type":   "zone_rp",
+		"search_fields": map[string]interface{}{"fqdn~": "^rpz"},
+		"return_fields": []interface{}{"fqdn", "ttl"},
+		"max_results":   2,
+	})
+	if diags := data.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read: %+v", diags)
+	}
+	if count := d.Get("refs.#").(int); count != 3 {
+		t.Fatalf("expected every result page to be followed, found %d refs", count)
+	}
+	var results []map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("results_json").(string)), &results); err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 3 || results[0]["fqdn"] != "rpz0.example.com" || results[0]["ttl"] != float64(3600) {
+		t.Fatalf("unexpected results %v", results)
+	}
+	if _, ok := results[0]["comment"]; ok {
+		t.Fatalf("expected only the return fields, found %v", results[0])
+	}
+	if results[0]["_ref"] != d.Get("refs.0") {
+		t.Fatalf("expected refs to follow the result order, found %v", d.Get("refs"))
+	}
+
+	d = schema.TestResourceDataRaw(t, data.Schema, map[string]interface{}{
+		"object_type": "zone_rp",
+		"paging":      false,
+		"max_results": 2,
+	})
+	if diags := data.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read: %+v", diags)
+	}
+	if count := d.Get("refs.#").(int); count != 2 {
+		t.Fatalf("expected max_results to limit an unpaged search, found %d refs", count)
+	}
+}
@@ -0,0 +1,386 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+var (
+	zoneRecordTypes = []string{
+		"A",
+		"AAAA",
+		"ALIAS",
+		"CNAME",
+		"MX",
+		"NS",
+		"PTR",
+		"SRV",
+		"TXT",
+	}
+	// zoneRecordValueFields lists the fields of each record object that make up
+	// its value, in zone file order
+	zoneRecordValueFields = map[string][]string{
+		"A":     {"ipv4addr"},
+		"AAAA":  {"ipv6addr"},
+		"ALIAS": {"target_name"},
+		"CNAME": {"canonical"},
+		"MX":    {"preference", "mail_exchanger"},
+		"NS":    {"nameserver"},
+		"PTR":   {"ptrdname"},
+		"SRV":   {"priority", "weight", "port", "target"},
+		"TXT":   {"text"},
+	}
+)
+
+func dataSourceZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceZoneRecordsRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Only return records with this name, relative to the zone.",
+				Optional:    true,
+			},
+			"query_params": {
+				Type:        schema.TypeMap,
+				Description: "Additional query parameters",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"records": {
+				Type:        schema.TypeList,
+				Description: "Records of the zone matching the search criteria.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"comment": {
+							Type:        schema.TypeString,
+							Description: "Comment for the record.",
+							Computed:    true,
+						},
+						"disable": {
+							Type:        schema.TypeBool,
+							Description: "Determines if the record is disabled or not.",
+							Computed:    true,
+						},
+						"extensible_attributes": {
+							Type:        schema.TypeMap,
+							Description: "Extensible attributes of the record (Values are JSON encoded).",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"fqdn": {
+							Type:        schema.TypeString,
+							Description: "The name of the record in FQDN format.",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the record relative to the zone; empty for the zone apex.",
+							Computed:    true,
+						},
+						"ref": {
+							Type:        schema.TypeString,
+							Description: "Reference id of the underlying record object.",
+							Computed:    true,
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Description: "The Time To Live (TTL) value of the record; 0 when the zone TTL is used.",
+							Computed:    true,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Description: "The DNS type of the record (A, AAAA, CNAME, ...).",
+							Computed:    true,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Description: "The record data, with multiple fields separated by spaces.",
+							Computed:    true,
+						},
+						"view": {
+							Type:        schema.TypeString,
+							Description: "The name of the DNS view in which the record resides.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"render_zone_file": {
+				Type:        schema.TypeBool,
+				Description: "Render the returned records as BIND zone file text in zone_file.",
+				Optional:    true,
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Description:      "Only return records of this DNS type. Host record addresses are returned as A and AAAA records.",
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(zoneRecordTypes, true)),
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Description: "The name of the DNS view in which the zone resides.",
+				Optional:    true,
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Description: "The name of the zone in FQDN format.",
+				Required:    true,
+			},
+			"zone_file": {
+				Type:        schema.TypeString,
+				Description: "The returned records as BIND zone file text, when render_zone_file is set.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// zoneRecordType returns the DNS type of an allrecords type, treating host
+// record addresses as the address records they publish
+func zoneRecordType(allRecordType string) string {
+	switch allRecordType {
+	case "record:host_ipv4addr":
+		return "A"
+	case "record:host_ipv6addr":
+		return "AAAA"
+	}
+	return strings.ToUpper(strings.TrimPrefix(allRecordType, "record:"))
+}
+
+// zoneRecordValue returns the value fields of a record object, falling back to
+// the allrecords address when the object does not have them
+func zoneRecordValue(recordType string, record *infoblox.AllRecord, object map[string]interface{}) []string {
+	var values []string
+	for _, field := range zoneRecordValueFields[recordType] {
+		if value, ok := object[field]; ok && value != nil {
+			values = append(values, fmt.Sprint(value))
+		}
+	}
+	if len(values) == 0 && record.Address != "" {
+		values = []string{record.Address}
+	}
+	return values
+}
+
+// zoneRecordRef returns the reference of the record object wrapped by an
+// allrecords result
+func zoneRecordRef(record *infoblox.AllRecord) string {
+	switch object := record.Record.(type) {
+	case string:
+		return object
+	case map[string]interface{}:
+		if ref, ok := object["_ref"].(string); ok {
+			return ref
+		}
+	}
+	return record.Ref
+}
+
+// zoneRecordObjects reads the record objects referenced by allrecords results,
+// which only return the reference of the object, keyed by reference. Records
+// are read with one search per record object type; host record addresses are
+// skipped as allrecords returns their address
+func zoneRecordObjects(client *infoblox.Client, records []*infoblox.AllRecord, queryParams map[string]string) (map[string]map[string]interface{}, error) {
+	objects := make(map[string]map[string]interface{})
+	searched := make(map[string]bool)
+	for _, record := range records {
+		if object, ok := record.Record.(map[string]interface{}); ok {
+			objects[zoneRecordRef(record)] = object
+			continue
+		}
+		objectType := strings.SplitN(zoneRecordRef(record), "/", 2)[0]
+		if searched[objectType] || !strings.HasPrefix(objectType, "record:") || strings.HasPrefix(objectType, "record:host_") {
+			continue
+		}
+		searched[objectType] = true
+
+		params := make(map[string]string)
+		for k, v := range queryParams {
+			params[k] = v
+		}
+		params["_return_fields"] = strings.Join(append([]string{"extattrs"}, zoneRecordValueFields[zoneRecordType(objectType)]...), ",")
+		found, err := client.GetWAPIObjectsByQuery(objectType, params, true)
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range found {
+			if ref, ok := object["_ref"].(string); ok {
+				objects[ref] = object
+			}
+		}
+	}
+	return objects, nil
+}
+
+func zoneRecordEAs(client *infoblox.Client, object map[string]interface{}) (map[string]string, error) {
+	if object["extattrs"] == nil {
+		return map[string]string{}, nil
+	}
+	raw, err := json.Marshal(object["extattrs"])
+	if err != nil {
+		return nil, err
+	}
+	var eas infoblox.ExtensibleAttribute
+	err = json.Unmarshal(raw, &eas)
+	if err != nil {
+		return nil, err
+	}
+	return client.ConvertEAsToJSONString(eas)
+}
+
+// zoneFileData formats record values as zone file record data, making domain
+// names absolute and quoting TXT strings
+func zoneFileData(recordType string, values []string) string {
+	data := append([]string{}, values...)
+	switch recordType {
+	case "ALIAS", "CNAME", "MX", "NS", "PTR", "SRV":
+		if last := len(data) - 1; last >= 0 && !strings.HasSuffix(data[last], ".") {
+			data[last] = data[last] + "."
+		}
+	case "TXT":
+		for i, text := range data {
+			if _, ok := joinTXTRecordText(text); !ok {
+				text = strings.ReplaceAll(text, `\`, `\\`)
+				text = strings.ReplaceAll(text, `"`, `\"`)
+				data[i] = fmt.Sprintf(`"%s"`, text)
+			}
+		}
+	}
+	return strings.Join(data, " ")
+}
+
+// renderZoneFile renders records as BIND zone file text, commenting out
+// disabled records
+func renderZoneFile(zone string, records []map[string]interface{}) string {
+	var zoneFile strings.Builder
+	fmt.Fprintf(&zoneFile, "$ORIGIN %s.\n", strings.TrimSuffix(zone, "."))
+	for _, record := range records {
+		name := record["name"].(string)
+		if name == "" {
+			name = "@"
+		}
+		ttl := ""
+		if record["ttl"].(int) > 0 {
+			ttl = fmt.Sprint(record["ttl"].(int))
+		}
+		prefix := ""
+		if record["disable"].(bool) {
+			prefix = "; "
+		}
+		fmt.Fprintf(&zoneFile, "%s%s\t%s\tIN\t%s\t%s\n", prefix, name, ttl, record["type"], record["zone_file_data"])
+	}
+	return zoneFile.String()
+}
+
+func dataSourceZoneRecordsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+
+	zone := d.Get("zone").(string)
+	recordType := strings.ToUpper(d.Get("type").(string))
+
+	queryParams := d.Get("query_params").(map[string]interface{})
+	resolvedQueryParams := make(map[string]string)
+
+	for k, v := range queryParams {
+		resolvedQueryParams[k] = v.(string)
+	}
+	resolvedQueryParams["zone"] = zone
+	if view := d.Get("view").(string); view != "" {
+		resolvedQueryParams["view"] = view
+	}
+	if name, ok := d.GetOk("name"); ok {
+		resolvedQueryParams["name"] = name.(string)
+	}
+
+	id := fmt.Sprintf("%d", schema.HashString(fmt.Sprintf("%s&type=%s", client.BuildQuery(resolvedQueryParams), recordType)))
+
+	records, err := client.GetAllRecordsByQuery(resolvedQueryParams)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	var matches []*infoblox.AllRecord
+	for i := range records {
+		if recordType == "" || zoneRecordType(records[i].Type) == recordType {
+			matches = append(matches, &records[i])
+		}
+	}
+
+	objectParams := map[string]string{
+		"zone": zone,
+	}
+	if view := d.Get("view").(string); view != "" {
+		objectParams["view"] = view
+	}
+	if name, ok := d.GetOk("name"); ok {
+		objectParams["name"] = fmt.Sprintf("%s.%s", name.(string), zone)
+	}
+	objects, err := zoneRecordObjects(client, matches, objectParams)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	recordList := []map[string]interface{}{}
+	for _, record := range matches {
+		normalizedType := zoneRecordType(record.Type)
+		object := objects[zoneRecordRef(record)]
+		eas, err := zoneRecordEAs(client, object)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+		fqdn := zone
+		if record.Name != "" {
+			fqdn = fmt.Sprintf("%s.%s", record.Name, zone)
+		}
+		ttl := 0
+		if record.TTL != nil {
+			ttl = *record.TTL
+		}
+		values := zoneRecordValue(normalizedType, record, object)
+		recordList = append(recordList, map[string]interface{}{
+			"ref":                   zoneRecordRef(record),
+			"name":                  record.Name,
+			"fqdn":                  fqdn,
+			"type":                  normalizedType,
+			"value":                 strings.Join(values, " "),
+			"ttl":                   ttl,
+			"view":                  record.View,
+			"comment":               record.Comment,
+			"disable":               record.Disable != nil && *record.Disable,
+			"extensible_attributes": eas,
+			"zone_file_data":        zoneFileData(normalizedType, values),
+		})
+	}
+
+	if d.Get("render_zone_file").(bool) {
+		d.Set("zone_file", renderZoneFile(zone, recordList))
+	} else {
+		d.Set("zone_file", "")
+	}
+	for _, record := range recordList {
+		delete(record, "zone_file_data")
+	}
+
+	d.Set("records", recordList)
+	d.SetId(id)
+
+	return diags
+}
@@ -0,0 +1,100 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestUnitDataZoneRecords(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	fake.create("record:a", map[string]interface{}{
+		"name":     "www.example.com",
+		"ipv4addr": "10.70.0.10",
+		"ttl":      300,
+		"use_ttl":  true,
+		"extattrs": map[string]interface{}{
+			"Location": map[string]interface{}{"value": "CollegeStation"},
+		},
+	})
+	fake.create("record:cname", map[string]interface{}{
+		"name":      "web.example.com",
+		"canonical": "www.example.com",
+	})
+	fake.create("record:mx", map[string]interface{}{
+		"name":           "mail.example.com",
+		"mail_exchanger": "mx1.example.com",
+		"preference":     10,
+	})
+	fake.create("record:txt", map[string]interface{}{
+		"name":    "spf.example.com",
+		"text":    "v=spf1 -all",
+		"disable": true,
+	})
+	fake.create("record:host", map[string]interface{}{
+		"name": "host.example.com",
+		"ipv4addrs": []interface{}{
+			map[string]interface{}{"ipv4addr": "10.70.0.20"},
+		},
+	})
+	fake.create("record:a", map[string]interface{}{
+		"name":     "www.example.org",
+		"ipv4addr": "10.70.1.10",
+	})
+
+	data := dataSourceZoneRecords()
+	for _, search := range []struct {
+		config   map[string]interface{}
+		expected int
+	}{
+		{map[string]interface{}{"zone": "example.com"}, 5},
+		{map[string]interface{}{"zone": "example.com", "type": "a"}, 2},
+		{map[string]interface{}{"zone": "example.com", "name": "web"}, 1},
+		{map[string]interface{}{"zone": "example.com", "query_params": map[string]interface{}{"_max_results": "2"}}, 5},
+		{map[string]interface{}{"zone": "example.net"}, 0},
+	} {
+		d := schema.TestResourceDataRaw(t, data.Schema, search.config)
+		if diags := data.ReadContext(context.Background(), d, client); diags.HasError() {
+			t.Fatalf("read %v: %+v", search.config, diags)
+		}
+		if count := d.Get("records.#").(int); count != search.expected {
+			t.Fatalf("read %v: expected %d records, found %d", search.config, search.expected, count)
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, data.Schema, map[string]interface{}{
+		"zone":             "example.com",
+		"render_zone_file": true,
+	})
+	if diags := data.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read: %+v", diags)
+	}
+	expected := map[string]string{
+		"name":                           "www",
+		"fqdn":                           "www.example.com",
+		"type":                           "A",
+		"value":                          "10.70.0.10",
+		"ttl":                            "300",
+		"extensible_attributes.Location": `{"value":"CollegeStation","type":"STRING"}`,
+	}
+	for k, v := range expected {
+		if actual := fmt.Sprint(d.Get("records.0." + k)); actual != v {
+			t.Fatalf("expected records.0.%s to be %s, found %s", k, v, actual)
+		}
+	}
+	if ref := d.Get("records.0.ref").(string); ref != fake.refs("record:a")[0] {
+		t.Fatalf("expected the ref of the underlying record, found %s", ref)
+	}
+	zoneFile := "$ORIGIN example.com.\n" +
+		"www\t300\tIN\tA\t10.70.0.10\n" +
+		"web\t\tIN\tCNAME\twww.example.com.\n" +
+		"mail\t\tIN\tMX\t10 mx1.example.com.\n" +
+		"; spf\t\tIN\tTXT\t\"v=spf1 -all\"\n" +
+		"host\t\tIN\tA\t10.70.0.20\n"
+	if actual := d.Get("zone_file").(string); actual != zoneFile {
+		t.Fatalf("expected zone file:\n%s\nfound:\n%s", zoneFile, actual)
+	}
+}
@@ -11,16 +11,48 @@ import (
 	infoblox "github.com/techBeck03/infoblox-go-sdk"
 )
 
+// plannedEAs returns the planned extensible attributes of arg and whether they
+// changed. Configured extensible_attribute blocks take the place of arg, so
+// the returned path is the argument to report problems against
+func plannedEAs(diff *schema.ResourceDiff, arg string, client *infoblox.Client) (map[string]interface{}, bool, string, error) {
+	if blocks, ok := diff.GetOk("extensible_attribute"); ok {
+		eaMap, err := createExtensibleAttributesJSONFromBlocks(blocks.(*schema.Set).List(), client)
+		if err != nil {
+			return nil, false, "", err
+		}
+		return eaMap, true, "extensible_attribute", nil
+	}
+	// arg is computed, so removing the last block only clears the extensible
+	// attributes when planned explicitly
+	if oldBlocks, _ := diff.GetChange("extensible_attribute"); oldBlocks.(*schema.Set).Len() > 0 {
+		if config := diff.GetRawConfig(); config.IsKnown() && !config.IsNull() && config.GetAttr(arg).IsNull() {
+			return map[string]interface{}{}, true, arg, nil
+		}
+	}
+	_, new := diff.GetChange(arg)
+	return new.(map[string]interface{}), diff.HasChange(arg), arg, nil
+}
+
 func makeEACustomDiff(arg string, ignored_eas ...string) func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 	return func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 		client := v.(*infoblox.Client)
 		var eas infoblox.ExtensibleAttribute
-		old, new := diff.GetChange(arg)
-		eaMap := new.(map[string]interface{})
+		old, _ := diff.GetChange(arg)
+		if !diff.NewValueKnown("extensible_attribute") {
+			return diff.SetNewComputed(arg)
+		}
+		eaMap, changed, eaPath, err := plannedEAs(diff, arg, client)
+		if err != nil {
+			return err
+		}
 		for _, ignored_ea := range ignored_eas {
 			eaMap[ignored_ea] = old.(map[string]interface{})[ignored_ea]
 		}
-		if diff.HasChange(arg) && len(eaMap) > 0 {
+		if changed && len(eaMap) > 0 {
+			err := validateEADefinitions(eaPath, eaMap, client)
+			if err != nil {
+				return err
+			}
 			localEAs, err := createExtensibleAttributesFromJSON(eaMap)
 			if err != nil {
 				return err
@@ -32,7 +64,7 @@ func makeEACustomDiff(arg string, ignored_eas ...string) func(_ context.Context,
 			if err != nil {
 				return err
 			}
-			newEAs, err := createExtensibleAttributesFromJSON(new.(map[string]interface{}))
+			newEAs, err := createExtensibleAttributesFromJSON(eaMap)
 			if err != nil {
 				return err
 			}
@@ -69,13 +101,23 @@ func makeEACustomDiffNetwork(arg string) func(_ context.Context, diff *schema.Re
 	return func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 		client := v.(*infoblox.Client)
 		var eas infoblox.ExtensibleAttribute
-		old, new := diff.GetChange(arg)
-		eaMap := new.(map[string]interface{})
+		old, _ := diff.GetChange(arg)
+		if !diff.NewValueKnown("extensible_attribute") {
+			return diff.SetNewComputed(arg)
+		}
+		eaMap, changed, eaPath, err := plannedEAs(diff, arg, client)
+		if err != nil {
+			return err
+		}
 		gateway_ea := diff.Get("gateway_ea").(string)
 		if gateway_ea != "" && old != nil && old.(map[string]interface{})[gateway_ea] != nil {
 			eaMap[gateway_ea] = old.(map[string]interface{})[gateway_ea]
 		}
-		if diff.HasChange(arg) && len(eaMap) > 0 {
+		if changed && len(eaMap) > 0 {
+			err := validateEADefinitions(eaPath, eaMap, client)
+			if err != nil {
+				return err
+			}
 			localEAs, err := createExtensibleAttributesFromJSON(eaMap)
 			if err != nil {
 				return err
@@ -87,7 +129,7 @@ func makeEACustomDiffNetwork(arg string) func(_ context.Context, diff *schema.Re
 			if err != nil {
 				return err
 			}
-			newEAs, err := createExtensibleAttributesFromJSON(new.(map[string]interface{}))
+			newEAs, err := createExtensibleAttributesFromJSON(eaMap)
 			if err != nil {
 				return err
 			}
@@ -144,36 +186,63 @@ func optionCustomDiff(_ context.Context, diff *schema.ResourceDiff, v interface{
 }
 
 func hostRecordAddressDiff(c context.Context, diff *schema.ResourceDiff, v interface{}) error {
-	old, new := diff.GetChange("ip_v4_address")
-	if diff.HasChange("ip_v4_address") {
-		ipAddressList := new.([]interface{})
-		atLeastOneOfFields := []string{
-			"ip_address",
-			"network",
-			"range_function_string",
+	// ip_v6_address is computed, so removing it from the configuration only
+	// clears the addresses when planned explicitly
+	if config := diff.GetRawConfig(); config.IsKnown() && !config.IsNull() && config.GetAttr("ip_v6_address").IsNull() {
+		if old, _ := diff.GetChange("ip_v6_address"); len(old.([]interface{})) > 0 {
+			if err := diff.SetNew("ip_v6_address", []interface{}{}); err != nil {
+				return err
+			}
+		}
+	}
+	for _, key := range []string{"ip_v4_address", "ip_v6_address"} {
+		if err := hostRecordAddressListDiff(diff, key, hostRecordAddressFields[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkHostRecordAddressFields checks that address sets exactly one of fields
+func checkHostRecordAddressFields(key string, address map[string]interface{}, fields []string) error {
+	matchArgs := []string{}
+	for _, f := range fields {
+		if address[f] != "" {
+			matchArgs = append(matchArgs, f)
 		}
+	}
+	if len(matchArgs) == 0 {
+		return fmt.Errorf("At least one of %s required for %s", strings.Join(fields, ", "), key)
+	} else if len(matchArgs) > 1 {
+		return fmt.Errorf("Only one of %s is allowed for %s but found %s", strings.Join(fields, ", "), key, strings.Join(matchArgs, ", "))
+	}
+	return nil
+}
+
+// hostRecordAddressListDiff checks that each address in the list sets exactly
+// one of fields and keeps allocated addresses stable across plans
+func hostRecordAddressListDiff(diff *schema.ResourceDiff, key string, fields []string) error {
+	old, new := diff.GetChange(key)
+	if diff.HasChange(key) {
+		ipAddressList := new.([]interface{})
 		addressList := new.([]interface{})
 		for k, address := range ipAddressList {
 			addr := address.(map[string]interface{})
 			if len(old.([]interface{})) > 0 {
-				matchArgs := []string{}
-				for _, f := range atLeastOneOfFields {
-					if addr[f] != "" {
-						matchArgs = append(matchArgs, f)
-					}
-				}
-				if len(matchArgs) == 0 {
-					return fmt.Errorf("At least one of %s required for ip_v4_address", strings.Join(atLeastOneOfFields, ", "))
-				} else if len(matchArgs) > 1 {
-					return fmt.Errorf("Only one of %s is allowed for ip_v4_address but found %s", strings.Join(atLeastOneOfFields, ", "), strings.Join(matchArgs, ", "))
+				if err := checkHostRecordAddressFields(key, addr, fields); err != nil {
+					return err
 				}
 			}
-			if addr["ip_address"].(string) == "" && len(old.([]interface{})) > 0 && old.([]interface{})[k].(map[string]interface{})["ip_address"].(string) != "" {
-				addr["ip_address"] = old.([]interface{})[k].(map[string]interface{})["ip_address"].(string)
+			if len(old.([]interface{})) > k {
+				// Keep allocated addresses, and the grid's spelling of configured ones
+				oldAddress := old.([]interface{})[k].(map[string]interface{})["ip_address"].(string)
+				if oldAddress != "" && (addr["ip_address"].(string) == "" || ipv6AddressSuppressDiff("ip_address", oldAddress, addr["ip_address"].(string), nil)) {
+					addr["ip_address"] = oldAddress
+				}
 			}
 			addressList[k] = addr
 		}
-		diff.SetNew("ip_v4_address", addressList)
+		diff.SetNew(key, addressList)
 	}
 	return nil
 }
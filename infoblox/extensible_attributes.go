@@ -0,0 +1,124 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+// extensibleAttributeBlockSchema is the typed alternative to the JSON encoded
+// extensible_attributes map. Blocks are converted into the map at plan time so
+// the rest of the resource only ever deals with extensible_attributes.
+func extensibleAttributeBlockSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeSet,
+		Description:   "Extensible attribute of the object. The type is looked up from the extensible attribute definition.",
+		Optional:      true,
+		ConflictsWith: []string{"extensible_attributes"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Description: "Name of the extensible attribute.",
+					Required:    true,
+				},
+				"value": {
+					Type:        schema.TypeString,
+					Description: "Value of the extensible attribute.",
+					Optional:    true,
+				},
+				"inheritance_operation": {
+					Type:             schema.TypeString,
+					Description:      "Inheritance operation applied to the extensible attribute.",
+					Optional:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validInheritanceOperations, false)),
+				},
+				"descendants_action": {
+					Type:        schema.TypeList,
+					Description: "Action applied to descendants inheriting the extensible attribute.",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"option_delete_ea": {
+								Type:             schema.TypeString,
+								Description:      "Action for descendants when the extensible attribute is deleted.",
+								Optional:         true,
+								ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validOptionDeleteEAValues, false)),
+							},
+							"option_with_ea": {
+								Type:             schema.TypeString,
+								Description:      "Action for descendants that already have the extensible attribute.",
+								Optional:         true,
+								ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validOptionWithEAValues, false)),
+							},
+							"option_without_ea": {
+								Type:             schema.TypeString,
+								Description:      "Action for descendants without the extensible attribute.",
+								Optional:         true,
+								ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validOptionWithoutEAValues, false)),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// createExtensibleAttributesJSONFromBlocks converts extensible_attribute blocks
+// into the JSON encoded map used by extensible_attributes
+func createExtensibleAttributesJSONFromBlocks(blocks []interface{}, client *infoblox.Client) (map[string]interface{}, error) {
+	eaMap := make(map[string]interface{})
+	err := client.GetEADefinitions(true)
+	if err != nil {
+		return nil, err
+	}
+	for _, block := range blocks {
+		attribute := block.(map[string]interface{})
+		name := attribute["name"].(string)
+		if _, ok := eaMap[name]; ok {
+			return nil, fmt.Errorf("Extensible attribute %s is defined more than once", name)
+		}
+		def, ok := client.PlannedEADefinition(name)
+		if !ok {
+			def, err = client.GetEADefinition(name)
+			if err != nil {
+				return nil, fmt.Errorf("extensible_attribute.%s: No extensible attribute definition found for %s", name, name)
+			}
+		}
+		ea := infoblox.ExtensibleAttributeJSONMapValue{
+			Type:                 def.Type,
+			InheritanceOperation: attribute["inheritance_operation"].(string),
+		}
+		if value := attribute["value"].(string); value != "" {
+			if def.Type == "INTEGER" {
+				intValue, err := strconv.Atoi(value)
+				if err != nil {
+					return nil, fmt.Errorf("Extensible attribute %s requires an integer value but found: %s", name, value)
+				}
+				ea.Value = intValue
+			} else {
+				ea.Value = value
+			}
+		}
+		if actions := attribute["descendants_action"].([]interface{}); len(actions) > 0 && actions[0] != nil {
+			action := actions[0].(map[string]interface{})
+			ea.DescendantsAction = &infoblox.DescendantsAction{
+				OptionDeleteEA:  action["option_delete_ea"].(string),
+				OptionWithEA:    action["option_with_ea"].(string),
+				OptionWithoutEA: action["option_without_ea"].(string),
+			}
+		}
+		stringVal, err := json.Marshal(ea)
+		if err != nil {
+			return nil, err
+		}
+		eaMap[name] = string(stringVal)
+	}
+	return eaMap, nil
+}
@@ -0,0 +1,218 @@
+package infoblox
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUnitExtensibleAttributeBlocks(t *testing.T) {
+	fake := newFakeWAPI(t)
+	r := resourceARecord()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"hostname":   "unit-a.example.com",
+		"ip_address": "10.50.0.10",
+		"extensible_attribute": []interface{}{
+			map[string]interface{}{
+				"name":  "Location",
+				"value": "CollegeStation",
+			},
+			map[string]interface{}{
+				"name":  "VLAN",
+				"value": "100",
+			},
+			map[string]interface{}{
+				"name":                  "Owner",
+				"inheritance_operation": "INHERIT",
+			},
+		},
+	})
+	diff, err := r.SimpleDiff(context.Background(), nil, config, fake.client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{
+		"extensible_attributes.Location": `{"value":"CollegeStation","type":"STRING"}`,
+		"extensible_attributes.VLAN":     `{"value":100,"type":"INTEGER"}`,
+		"extensible_attributes.Owner":    `{"value":"","type":"STRING","inheritance_operation":"INHERIT"}`,
+	}
+	for k, v := range expected {
+		attr, ok := diff.Attributes[k]
+		if !ok {
+			t.Fatalf("expected %s in plan, found %+v", k, diff.Attributes)
+		}
+		if equal, _ := areEqualJSON(attr.New, v); !equal {
+			t.Fatalf("expected %s to be %s, found %s", k, v, attr.New)
+		}
+	}
+
+	_, err = createExtensibleAttributesJSONFromBlocks([]interface{}{
+		map[string]interface{}{
+			"name":                  "VLAN",
+			"value":                 "one hundred",
+			"inheritance_operation": "",
+			"descendants_action":    []interface{}{},
+		},
+	}, fake.client())
+	if err == nil {
+		t.Fatal("expected an error for a non integer value of an INTEGER extensible attribute")
+	}
+
+	_, err = createExtensibleAttributesJSONFromBlocks([]interface{}{
+		map[string]interface{}{
+			"name":                  "Undefined",
+			"value":                 "value",
+			"inheritance_operation": "",
+			"descendants_action":    []interface{}{},
+		},
+	}, fake.client())
+	if err == nil {
+		t.Fatal("expected an error for an extensible attribute without a definition")
+	}
+}
+
+func TestUnitExtensibleAttributeBlocksRemoved(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	r := resourceARecord()
+	d := testUnitPlanCreate(t, r, map[string]interface{}{
+		"hostname":   "unit-a.example.com",
+		"ip_address": "10.50.0.10",
+		"extensible_attribute": []interface{}{
+			map[string]interface{}{
+				"name":  "Location",
+				"value": "CollegeStation",
+			},
+		},
+	}, client)
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if object, _ := fake.lookup(d.Id()); len(object["extattrs"].(map[string]interface{})) != 1 {
+		t.Fatalf("expected the Location extensible attribute on create, found %v", object["extattrs"])
+	}
+
+	// Terraform proposes the prior value for the computed extensible_attributes,
+	// so only the raw configuration shows that it is no longer set
+	config := map[string]interface{}{
+		"hostname":   "unit-a.example.com",
+		"ip_address": "10.50.0.10",
+	}
+	state := testUnitRawConfigState(t, r, d.State(), config)
+	proposed := map[string]interface{}{
+		"extensible_attributes": d.Get("extensible_attributes"),
+	}
+	for k, v := range config {
+		proposed[k] = v
+	}
+	diff, err := r.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(proposed), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err = schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !d.HasChange("extensible_attributes") {
+		t.Fatal("expected removing the last extensible_attribute block to change extensible_attributes")
+	}
+	if eas := d.Get("extensible_attributes").(map[string]interface{}); len(eas) != 0 {
+		t.Fatalf("expected no planned extensible attributes, found %v", eas)
+	}
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if object, _ := fake.lookup(d.Id()); len(object["extattrs"].(map[string]interface{})) != 0 {
+		t.Fatalf("expected the extensible attributes to be removed, found %v", object["extattrs"])
+	}
+}
+
+func TestUnitExtensibleAttributeDefinitionValidation(t *testing.T) {
+	fake := newFakeWAPI(t)
+	r := resourceARecord()
+	valid := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"hostname":   "unit-a.example.com",
+		"ip_address": "10.50.0.10",
+		"extensible_attributes": map[string]interface{}{
+			"Orchestrator": `{"value":"Terraform","type":"ENUM"}`,
+			"VLAN":         `{"value":4094,"type":"INTEGER"}`,
+			"Contact":      `{"value":"netops@example.com","type":"EMAIL"}`,
+			"Wiki":         `{"value":"https://wiki.example.com/netops","type":"URL"}`,
+			"Expires":      `{"value":"2030-01-01","type":"DATE"}`,
+			"Owner":        `{"type":"STRING","inheritance_operation":"INHERIT"}`,
+		},
+	})
+	if _, err := r.SimpleDiff(context.Background(), nil, valid, fake.client()); err != nil {
+		t.Fatalf("expected valid extensible attributes to plan: %s", err)
+	}
+
+	invalid := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"hostname":   "unit-a.example.com",
+		"ip_address": "10.50.0.10",
+		"extensible_attributes": map[string]interface{}{
+			"Orchestrator": `{"value":"Terraforn","type":"ENUM"}`,
+			"VLAN":         `{"value":5000,"type":"INTEGER"}`,
+			"Contact":      `{"value":"netops","type":"EMAIL"}`,
+			"Wiki":         `{"value":"wiki","type":"URL"}`,
+			"Expires":      `{"value":"01/01/2030","type":"DATE"}`,
+			"Owner":        `{"value":"a-very-long-owner-name-for-this-record","type":"STRING"}`,
+			"Location":     `{"value":"CollegeStation","type":"ENUM"}`,
+			"Managed":      `{"value":"yes","type":"STRING"}`,
+			"Misspelled":   `{"value":"value","type":"STRING"}`,
+		},
+	})
+	_, err := r.SimpleDiff(context.Background(), nil, invalid, fake.client())
+	if err == nil {
+		t.Fatal("expected invalid extensible attributes to fail planning")
+	}
+	for _, expected := range []string{
+		"extensible_attributes.Orchestrator: Expected value to be one of Terraform, Manual but found Terraforn",
+		"extensible_attributes.VLAN: Expected value to be at most 4094 but found 5000",
+		"extensible_attributes.Contact: Expected a valid email address",
+		"extensible_attributes.Wiki: Expected a valid URL",
+		"extensible_attributes.Expires: Expected a date",
+		"extensible_attributes.Owner: Expected value to be at most 32 characters",
+		"extensible_attributes.Location: Expected type to be STRING but found ENUM",
+		"extensible_attributes.Managed: Extensible attribute definition is read only",
+		"extensible_attributes.Misspelled: No extensible attribute definition found for Misspelled",
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Fatalf("expected error to contain %q, found:\n%s", expected, err)
+		}
+	}
+	var merr *multierror.Error
+	if !errors.As(err, &merr) || len(merr.Errors) != 9 {
+		t.Fatalf("expected one error per invalid extensible attribute, found:\n%s", err)
+	}
+
+	blocks := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"hostname":   "unit-a.example.com",
+		"ip_address": "10.50.0.10",
+		"extensible_attribute": []interface{}{
+			map[string]interface{}{
+				"name":                  "VLAN",
+				"value":                 "0",
+				"inheritance_operation": "INHERIT",
+			},
+		},
+	})
+	_, err = r.SimpleDiff(context.Background(), nil, blocks, fake.client())
+	if err == nil {
+		t.Fatal("expected invalid extensible attribute blocks to fail planning")
+	}
+	for _, expected := range []string{
+		"extensible_attribute.VLAN: Extensible attribute definition is not inheritable; Expected value to be at least 1 but found 0",
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Fatalf("expected error to contain %q, found:\n%s", expected, err)
+		}
+	}
+	if !errors.As(err, &merr) || len(merr.Errors) != 1 {
+		t.Fatalf("expected a single error for the invalid extensible attribute block, found:\n%s", err)
+	}
+}
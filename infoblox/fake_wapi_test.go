@@ -0,0 +1,1707 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+const (
+	fakeWAPIVersion  = "2.11"
+	fakeWAPIUsername = "admin"
+	fakeWAPIPassword = "infoblox"
+)
+
+// fakeWAPIEADefinitions are the extensible attribute definitions every fake grid starts with
+var fakeWAPIEADefinitions = []map[string]interface{}{
+	{"name": "Location", "type": "STRING", "flags": "I"},
+	{"name": "Owner", "type": "STRING", "flags": "I", "max": 32},
+	{"name": "Gateway", "type": "STRING", "flags": "I"},
+	{"name": "Site", "type": "STRING", "flags": "I"},
+	{"name": "Contact", "type": "EMAIL"},
+	{"name": "Wiki", "type": "URL"},
+	{"name": "Expires", "type": "DATE"},
+	{"name": "Managed", "type": "STRING", "flags": "R"},
+	{"name": "Orchestrator", "type": "ENUM", "list_values": []interface{}{
+		map[string]interface{}{"value": "Terraform"},
+		map[string]interface{}{"value": "Manual"},
+	}},
+	{"name": "VLAN", "type": "INTEGER", "min": 1, "max": 4094},
+}
+
+// fakeWAPI is a stateful, in-process stand-in for the subset of the Infoblox
+// WAPI used by the provider so resources can be unit tested without a grid.
+type fakeWAPI struct {
+	mu      sync.Mutex
+	server  *httptest.Server
+	nextID  int
+	objects map[string]map[string]interface{}
+	order   []string
+	// failures are served, in order, instead of handling matching requests
+	failures []fakeWAPIFailure
+	// attempts counts the requests received per method
+	attempts map[string]int
+	// restarts records the bodies of restartservices calls
+	restarts []map[string]interface{}
+	// discovered holds network discovery data per IPv4 address
+	discovered map[string]map[string]interface{}
+}
+
+// fakeWAPIFailure is an injected error response
+type fakeWAPIFailure struct {
+	method string
+	status int
+	text   string
+	// page is sent as an HTML error page, as a proxy in front of the grid
+	// would, in place of a WAPI error
+	page bool
+	// drop applies the request and then closes the connection without
+	// sending the response
+	drop bool
+}
+
+// newFakeWAPI starts a fake WAPI server that is shut down when the test ends
+func newFakeWAPI(t *testing.T) *fakeWAPI {
+	t.Helper()
+
+	f := &fakeWAPI{
+		objects:    make(map[string]map[string]interface{}),
+		attempts:   make(map[string]int),
+		discovered: make(map[string]map[string]interface{}),
+	}
+	f.server = httptest.NewTLSServer(f)
+	t.Cleanup(f.server.Close)
+
+	f.create("grid", map[string]interface{}{
+		"name":           "Infoblox",
+		"service_status": "ONLINE",
+		"dns_resolver_setting": map[string]interface{}{
+			"resolvers":      []interface{}{"10.0.0.53"},
+			"search_domains": []interface{}{"example.com"},
+		},
+	})
+	f.create("networkview", map[string]interface{}{
+		"name":       "default",
+		"is_default": true,
+	})
+	f.create("view", map[string]interface{}{
+		"name":       "default",
+		"is_default": true,
+	})
+	for _, def := range fakeWAPIEADefinitions {
+		f.create("extensibleattributedef", copyObject(def))
+	}
+
+	return f
+}
+
+// providerConfig returns a provider block pointing at the fake server
+func (f *fakeWAPI) providerConfig(extra ...string) string {
+	serverURL, _ := url.Parse(f.server.URL)
+	return fmt.Sprintf(`
+  provider infoblox {
+    hostname                 = "%s"
+    port                     = "%s"
+    username                 = "%s"
+    password                 = "%s"
+    wapi_version             = "%s"
+    disable_tls_verification = true
+    %s
+  }
+`, serverURL.Hostname(), serverURL.Port(), fakeWAPIUsername, fakeWAPIPassword, fakeWAPIVersion, strings.Join(extra, "\n"))
+}
+
+// client returns an sdk client configured for the fake server
+func (f *fakeWAPI) client(options ...func(*infoblox.Config)) *infoblox.Client {
+	serverURL, _ := url.Parse(f.server.URL)
+	config := infoblox.Config{
+		Host:                   serverURL.Hostname(),
+		Port:                   serverURL.Port(),
+		Version:                fakeWAPIVersion,
+		Username:               fakeWAPIUsername,
+		Password:               fakeWAPIPassword,
+		DisableTLSVerification: true,
+	}
+	for _, option := range options {
+		option(&config)
+	}
+	client := infoblox.New(config)
+	return &client
+}
+
+// fail queues count error responses for the next requests using method
+func (f *fakeWAPI) fail(method string, status int, text string, count int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i := 0; i < count; i++ {
+		f.failures = append(f.failures, fakeWAPIFailure{method: method, status: status, text: text})
+	}
+}
+
+// failPage queues count HTML error pages, as returned by a proxy in front of
+// the grid, for the next requests using method
+func (f *fakeWAPI) failPage(method string, status int, text string, count int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i := 0; i < count; i++ {
+		f.failures = append(f.failures, fakeWAPIFailure{method: method, status: status, text: text, page: true})
+	}
+}
+
+// drop applies the next count requests using method and then closes their
+// connections without a response, as when the network fails mid request
+func (f *fakeWAPI) drop(method string, count int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i := 0; i < count; i++ {
+		f.failures = append(f.failures, fakeWAPIFailure{method: method, drop: true})
+	}
+}
+
+// attemptCount returns the number of requests received using method
+func (f *fakeWAPI) attemptCount(method string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.attempts[method]
+}
+
+// create stores an object directly in the fake and returns its ref
+func (f *fakeWAPI) create(objType string, obj map[string]interface{}) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.applyDefaults(objType, obj)
+	return f.store(objType, obj)
+}
+
+// discover records network discovery data for ip, as reported in the
+// discovered_data of its ipv4address object
+func (f *fakeWAPI) discover(ip string, data map[string]interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.discovered[ip] = data
+}
+
+// restartRequests returns the restartservices requests received so far
+func (f *fakeWAPI) restartRequests() []map[string]interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]map[string]interface{}{}, f.restarts...)
+}
+
+// lookup returns a copy of the object stored for ref
+func (f *fakeWAPI) lookup(ref string) (map[string]interface{}, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	obj, ok := f.objects[ref]
+	if !ok {
+		return nil, false
+	}
+	return copyObject(obj), true
+}
+
+// remove deletes an object out-of-band, as if done from the grid GUI
+func (f *fakeWAPI) remove(ref string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.delete(ref)
+}
+
+// modify changes fields of a stored object, like a change made outside of
+// terraform
+func (f *fakeWAPI) modify(ref string, fields map[string]interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for k, v := range fields {
+		f.objects[ref][k] = v
+	}
+}
+
+// refs lists the refs of all stored objects of objType
+func (f *fakeWAPI) refs(objType string) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var refs []string
+	for _, ref := range f.order {
+		if refObjectType(ref) == objType {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+func (f *fakeWAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	username, password, ok := r.BasicAuth()
+	if !ok || username != fakeWAPIUsername || password != fakeWAPIPassword {
+		writeWAPIError(w, http.StatusUnauthorized, "Client.Ibap.Auth", "Authorization required")
+		return
+	}
+
+	f.attempts[r.Method]++
+	for i, failure := range f.failures {
+		if failure.method == r.Method {
+			f.failures = append(f.failures[:i], f.failures[i+1:]...)
+			if failure.drop {
+				conn := w
+				defer func() {
+					if c, _, err := conn.(http.Hijacker).Hijack(); err == nil {
+						c.Close()
+					}
+				}()
+				w = httptest.NewRecorder()
+				break
+			}
+			if failure.page {
+				w.Header().Set("Content-Type", "text/html")
+				w.WriteHeader(failure.status)
+				fmt.Fprintf(w, "<html><head><title>%d %s</title></head><body><h1>%s</h1></body></html>", failure.status, failure.text, failure.text)
+				return
+			}
+			writeWAPIError(w, failure.status, "Server.Ibap.Fake", failure.text)
+			return
+		}
+	}
+
+	prefix := fmt.Sprintf("/wapi/v%s/", fakeWAPIVersion)
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		writeWAPIError(w, http.StatusBadRequest, "Client.Ibap.Proto", fmt.Sprintf("Unknown WAPI path %s", r.URL.Path))
+		return
+	}
+	path := strings.TrimPrefix(r.URL.Path, prefix)
+	query := r.URL.Query()
+
+	var body interface{}
+	if r.Body != nil {
+		json.NewDecoder(r.Body).Decode(&body)
+	}
+
+	isRef := strings.Contains(path, "/")
+	switch {
+	case r.Method == http.MethodGet && isRef:
+		f.handleGetRef(w, path, query)
+	case r.Method == http.MethodGet:
+		f.handleList(w, path, query)
+	case r.Method == http.MethodPost && path == "logout":
+		writeJSON(w, http.StatusOK, map[string]interface{}{})
+	case r.Method == http.MethodPost && query.Get("_function") != "":
+		f.handleFunction(w, path, query, body)
+	case r.Method == http.MethodPost:
+		f.handleCreate(w, path, query, body)
+	case r.Method == http.MethodPut && isRef:
+		f.handleUpdate(w, path, query, body)
+	case r.Method == http.MethodDelete && isRef:
+		f.handleDelete(w, path)
+	default:
+		writeWAPIError(w, http.StatusBadRequest, "Client.Ibap.Proto", fmt.Sprintf("Unsupported %s on %s", r.Method, path))
+	}
+}
+
+func (f *fakeWAPI) handleGetRef(w http.ResponseWriter, ref string, query url.Values) {
+	obj, ok := f.objects[ref]
+	if !ok {
+		writeNotFound(w, ref)
+		return
+	}
+	if refObjectType(ref) == "range" {
+		obj = f.rangeStatistics(obj)
+	}
+	writeJSON(w, http.StatusOK, returnFields(obj, query))
+}
+
+func (f *fakeWAPI) handleList(w http.ResponseWriter, objType string, query url.Values) {
+	var candidates []map[string]interface{}
+	if objType == "ipv4address" {
+		addresses, err := f.ipv4Addresses(query)
+		if err != nil {
+			writeWAPIError(w, http.StatusBadRequest, "Client.Ibap.Proto", err.Error())
+			return
+		}
+		candidates = addresses
+	} else if objType == "allrecords" {
+		candidates = f.allRecords()
+	} else if objType == "ipam:statistics" {
+		statistics, err := f.ipamStatistics()
+		if err != nil {
+			writeWAPIError(w, http.StatusBadRequest, "Client.Ibap.Proto", err.Error())
+			return
+		}
+		candidates = statistics
+	} else {
+		for _, ref := range f.order {
+			if refObjectType(ref) == objType {
+				obj := f.objects[ref]
+				if objType == "range" {
+					obj = f.rangeStatistics(obj)
+				}
+				candidates = append(candidates, obj)
+			}
+		}
+	}
+
+	var matches []map[string]interface{}
+	for _, obj := range candidates {
+		matched, err := matchesQuery(obj, query)
+		if err != nil {
+			writeWAPIError(w, http.StatusBadRequest, "Client.Ibap.Proto", err.Error())
+			return
+		}
+		if matched {
+			matches = append(matches, returnFields(obj, query))
+		}
+	}
+
+	var nextPageID string
+	maxResults, _ := strconv.Atoi(query.Get("_max_results"))
+	// A negative _max_results truncates the results instead of failing
+	truncate := maxResults < 0
+	if truncate {
+		maxResults = -maxResults
+	}
+	if query.Get("_paging") == "1" {
+		if query.Get("_return_as_object") != "1" || maxResults == 0 {
+			writeWAPIError(w, http.StatusBadRequest, "Client.Ibap.Proto", "_paging requires _return_as_object and _max_results")
+			return
+		}
+		offset := 0
+		if pageID := query.Get("_page_id"); pageID != "" {
+			offset, _ = strconv.Atoi(strings.TrimPrefix(pageID, "fakepage:"))
+		}
+		if offset > len(matches) {
+			offset = len(matches)
+		}
+		end := offset + maxResults
+		if end < len(matches) {
+			nextPageID = fmt.Sprintf("fakepage:%d", end)
+		} else {
+			end = len(matches)
+		}
+		matches = matches[offset:end]
+	} else if maxResults > 0 && len(matches) > maxResults {
+		if !truncate {
+			writeWAPIError(w, http.StatusBadRequest, "Client.Ibap.Proto", fmt.Sprintf("Result set too large (> %d)", maxResults))
+			return
+		}
+		matches = matches[:maxResults]
+	}
+	if matches == nil {
+		matches = []map[string]interface{}{}
+	}
+
+	if query.Get("_return_as_object") == "1" {
+		result := map[string]interface{}{
+			"result": matches,
+		}
+		if nextPageID != "" {
+			result["next_page_id"] = nextPageID
+		}
+		writeJSON(w, http.StatusOK, result)
+		return
+	}
+	writeJSON(w, http.StatusOK, matches)
+}
+
+func (f *fakeWAPI) handleCreate(w http.ResponseWriter, objType string, query url.Values, body interface{}) {
+	obj, ok := body.(map[string]interface{})
+	if !ok {
+		writeWAPIError(w, http.StatusBadRequest, "Client.Ibap.Proto", "Request body must be a JSON object")
+		return
+	}
+	if err := f.resolveFunctions(objType, obj); err != nil {
+		writeWAPIError(w, http.StatusBadRequest, "Client.Ibap.Data", err.Error())
+		return
+	}
+	f.applyDefaults(objType, obj)
+	if existing := f.findDuplicate(objType, obj, ""); existing != "" {
+		writeWAPIError(w, http.StatusBadRequest, "Client.Ibap.Data.Conflict", fmt.Sprintf("The object %s already exists", existing))
+		return
+	}
+	ref := f.store(objType, obj)
+	f.writeObjectResponse(w, ref, query)
+}
+
+func (f *fakeWAPI) handleUpdate(w http.ResponseWriter, ref string, query url.Values, body interface{}) {
+	obj, ok := f.objects[ref]
+	if !ok {
+		writeNotFound(w, ref)
+		return
+	}
+	changes, ok := body.(map[string]interface{})
+	if !ok {
+		writeWAPIError(w, http.StatusBadRequest, "Client.Ibap.Proto", "Request body must be a JSON object")
+		return
+	}
+	objType := refObjectType(ref)
+	updated := copyObject(obj)
+	if err := f.resolveFunctions(objType, changes); err != nil {
+		writeWAPIError(w, http.StatusBadRequest, "Client.Ibap.Data", err.Error())
+		return
+	}
+	for k, v := range changes {
+		switch k {
+		case "extattrs+":
+			eas, _ := updated["extattrs"].(map[string]interface{})
+			if eas == nil {
+				eas = make(map[string]interface{})
+			}
+			for name, value := range v.(map[string]interface{}) {
+				eas[name] = stripEAOperations(value)
+			}
+			updated["extattrs"] = eas
+		case "extattrs-":
+			eas, _ := updated["extattrs"].(map[string]interface{})
+			for name := range v.(map[string]interface{}) {
+				delete(eas, name)
+			}
+		default:
+			updated[k] = v
+		}
+	}
+	f.applyDefaults(objType, updated)
+	if existing := f.findDuplicate(objType, updated, ref); existing != "" {
+		writeWAPIError(w, http.StatusBadRequest, "Client.Ibap.Data.Conflict", fmt.Sprintf("The object %s already exists", existing))
+		return
+	}
+
+	// Like the real WAPI, a changed object key results in a new ref
+	newRef := f.makeRef(objType, refID(ref), updated)
+	delete(f.objects, ref)
+	for i, r := range f.order {
+		if r == ref {
+			f.order[i] = newRef
+		}
+	}
+	updated["_ref"] = newRef
+	f.objects[newRef] = updated
+	f.writeObjectResponse(w, newRef, query)
+}
+
+func (f *fakeWAPI) handleDelete(w http.ResponseWriter, ref string) {
+	if _, ok := f.objects[ref]; !ok {
+		writeNotFound(w, ref)
+		return
+	}
+	f.delete(ref)
+	writeJSON(w, http.StatusOK, ref)
+}
+
+func (f *fakeWAPI) handleFunction(w http.ResponseWriter, ref string, query url.Values, body interface{}) {
+	if _, ok := f.objects[ref]; !ok {
+		writeNotFound(w, ref)
+		return
+	}
+	args, _ := body.(map[string]interface{})
+	switch query.Get("_function") {
+	case "restartservices":
+		f.restarts = append(f.restarts, args)
+		writeJSON(w, http.StatusOK, map[string]interface{}{})
+	case "next_available_ip":
+		num := 1
+		if n, ok := args["num"].(float64); ok {
+			num = int(n)
+		}
+		var exclude []string
+		if e, ok := args["exclude"].([]interface{}); ok {
+			for _, ip := range e {
+				exclude = append(exclude, ip.(string))
+			}
+		}
+		ips, err := f.nextAvailableIPs(fmt.Sprint(f.objects[ref]["network"]), num, exclude, nil)
+		if err != nil {
+			writeWAPIError(w, http.StatusBadRequest, "Client.Ibap.Data", err.Error())
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"ips": ips})
+	default:
+		writeWAPIError(w, http.StatusBadRequest, "Client.Ibap.Proto", fmt.Sprintf("Unknown function %s", query.Get("_function")))
+	}
+}
+
+func (f *fakeWAPI) writeObjectResponse(w http.ResponseWriter, ref string, query url.Values) {
+	obj := returnFields(f.objects[ref], query)
+	if query.Get("_return_as_object") == "1" {
+		writeJSON(w, http.StatusCreated, map[string]interface{}{"result": obj})
+	} else if query.Get("_return_fields") != "" || query.Get("_return_fields+") != "" {
+		writeJSON(w, http.StatusCreated, obj)
+	} else {
+		writeJSON(w, http.StatusCreated, ref)
+	}
+}
+
+func (f *fakeWAPI) store(objType string, obj map[string]interface{}) string {
+	f.nextID++
+	ref := f.makeRef(objType, strconv.Itoa(f.nextID), obj)
+	obj["_ref"] = ref
+	f.objects[ref] = obj
+	f.order = append(f.order, ref)
+	return ref
+}
+
+func (f *fakeWAPI) delete(ref string) {
+	delete(f.objects, ref)
+	for i, r := range f.order {
+		if r == ref {
+			f.order = append(f.order[:i], f.order[i+1:]...)
+			break
+		}
+	}
+}
+
+func (f *fakeWAPI) makeRef(objType string, id string, obj map[string]interface{}) string {
+	var key string
+	switch objType {
+	case "network", "networkcontainer", "ipv6network", "ipv6networkcontainer":
+		key = fmt.Sprintf("%s/%s", obj["network"], obj["network_view"])
+	case "range":
+		key = fmt.Sprintf("%s/%s/%s", obj["start_addr"], obj["end_addr"], obj["network_view"])
+	case "networkview", "view":
+		key = fmt.Sprintf("%s/%v", obj["name"], obj["is_default"])
+	case "fixedaddress":
+		key = fmt.Sprintf("%s/%s", obj["ipv4addr"], obj["network_view"])
+	case "lease":
+		key = fmt.Sprintf("%s/%s", obj["address"], obj["network_view"])
+	case "ipv6fixedaddress":
+		key = fmt.Sprintf("%s/%s/%s", obj["duid"], obj["ipv6addr"], obj["network_view"])
+	case "record:host", "record:a", "record:aaaa", "record:cname", "record:ptr", "record:alias", "record:mx", "record:txt", "record:srv":
+		key = fmt.Sprintf("%s/%s", obj["name"], obj["view"])
+	case "zone_auth", "zone_delegated", "zone_forward":
+		key = fmt.Sprintf("%s/%s", obj["fqdn"], obj["view"])
+	default:
+		key = fmt.Sprint(obj["name"])
+	}
+	return fmt.Sprintf("%s/ZmFrZS5%s:%s", objType, id, key)
+}
+
+// applyDefaults fills in the fields the grid computes or defaults on its own
+func (f *fakeWAPI) applyDefaults(objType string, obj map[string]interface{}) {
+	canonicalIPv6(obj)
+	if objType != "extensibleattributedef" && objType != "grid" && objType != "lease" {
+		if _, ok := obj["extattrs"]; !ok {
+			obj["extattrs"] = map[string]interface{}{}
+		}
+		eas := obj["extattrs"].(map[string]interface{})
+		for name, value := range eas {
+			eas[name] = stripEAOperations(value)
+		}
+	}
+	switch objType {
+	case "networkview":
+		setDefault(obj, "is_default", false)
+	case "view":
+		setDefault(obj, "is_default", false)
+		setDefault(obj, "network_view", "default")
+		setDefault(obj, "disable", false)
+		setDefault(obj, "recursion", false)
+		setDefault(obj, "use_forwarders", false)
+		setDefault(obj, "forward_only", false)
+	case "network", "networkcontainer", "ipv6network", "ipv6networkcontainer", "range", "fixedaddress", "ipv6fixedaddress":
+		setDefault(obj, "network_view", "default")
+	case "record:host":
+		setDefault(obj, "network_view", "default")
+		setDefault(obj, "view", "default")
+		setDefault(obj, "configure_for_dns", true)
+		obj["zone"] = zoneFromName(fmt.Sprint(obj["name"]))
+		addresses, _ := obj["ipv4addrs"].([]interface{})
+		for _, a := range addresses {
+			address := a.(map[string]interface{})
+			address["host"] = obj["name"]
+			address["_ref"] = fmt.Sprintf("record:host_ipv4addr/ZmFrZS5ob3N0:%s/%s/%s", address["ipv4addr"], obj["name"], obj["view"])
+			setDefault(address, "configure_for_dhcp", false)
+			setDefault(address, "use_for_ea_inheritance", false)
+			if network := f.containingNetwork(fmt.Sprint(address["ipv4addr"])); network != "" {
+				address["network"] = network
+			}
+			delete(address, "_object_function")
+			delete(address, "_object")
+			delete(address, "_object_parameters")
+			delete(address, "_parameters")
+			delete(address, "_result_field")
+		}
+		ipv6Addresses, _ := obj["ipv6addrs"].([]interface{})
+		for _, a := range ipv6Addresses {
+			address := a.(map[string]interface{})
+			canonicalIPv6(address)
+			address["host"] = obj["name"]
+			address["_ref"] = fmt.Sprintf("record:host_ipv6addr/ZmFrZS5ob3N0:%s/%s/%s", address["ipv6addr"], obj["name"], obj["view"])
+			setDefault(address, "configure_for_dhcp", false)
+			if network := f.containingNetwork(fmt.Sprint(address["ipv6addr"])); network != "" {
+				address["network"] = network
+			}
+		}
+	case "record:a", "record:aaaa", "record:cname", "record:ptr", "record:alias", "record:mx", "record:txt", "record:srv":
+		setDefault(obj, "view", "default")
+		setDefault(obj, "disable", false)
+		if name, ok := obj["name"]; ok {
+			obj["dns_name"] = name
+			obj["zone"] = zoneFromName(fmt.Sprint(name))
+		}
+	case "zone_auth":
+		setDefault(obj, "view", "default")
+		setDefault(obj, "zone_format", "FORWARD")
+		setDefault(obj, "disable", false)
+		for _, flag := range []string{"use_allow_transfer", "use_allow_update", "use_grid_zone_timer", "use_soa_email"} {
+			setDefault(obj, flag, false)
+		}
+		// The zone reports the grid timers it does not override
+		for key, value := range fakeGridZoneTimers {
+			if obj["use_grid_zone_timer"] == true {
+				setDefault(obj, key, value)
+			} else {
+				obj[key] = value
+			}
+		}
+		obj["dns_fqdn"] = obj["fqdn"]
+	case "zone_delegated", "zone_forward":
+		setDefault(obj, "view", "default")
+		setDefault(obj, "zone_format", "FORWARD")
+		setDefault(obj, "disable", false)
+		obj["dns_fqdn"] = obj["fqdn"]
+	}
+	switch objType {
+	case "network", "networkcontainer":
+		if objType == "network" {
+			setDefault(obj, "disable", false)
+		}
+		obj["network_container"] = f.parentContainer(fmt.Sprint(obj["network"]), fmt.Sprint(obj["network_view"]))
+	case "fixedaddress":
+		setDefault(obj, "match_client", "MAC_ADDRESS")
+		if network := f.containingNetwork(fmt.Sprint(obj["ipv4addr"])); network != "" {
+			obj["network"] = network
+		}
+	case "ipv6fixedaddress":
+		setDefault(obj, "address_type", "ADDRESS")
+		if network := f.containingNetwork(fmt.Sprint(obj["ipv6addr"])); network != "" {
+			obj["network"] = network
+		}
+	case "record:cname":
+		obj["dns_canonical"] = obj["canonical"]
+	case "record:alias":
+		obj["dns_target_name"] = obj["target_name"]
+	case "record:ptr":
+		obj["dns_ptrdname"] = obj["ptrdname"]
+	case "record:mx":
+		obj["dns_mail_exchanger"] = obj["mail_exchanger"]
+	case "record:srv":
+		obj["dns_target"] = obj["target"]
+	case "zone_delegated":
+		setDefault(obj, "use_delegated_ttl", false)
+	case "zone_forward":
+		setDefault(obj, "forwarders_only", false)
+	}
+}
+
+func (f *fakeWAPI) findDuplicate(objType string, obj map[string]interface{}, ignoreRef string) string {
+	var keys []string
+	switch objType {
+	case "network", "networkcontainer", "ipv6network", "ipv6networkcontainer":
+		keys = []string{"network", "network_view"}
+	case "extensibleattributedef", "networkview", "view":
+		keys = []string{"name"}
+	case "fixedaddress":
+		keys = []string{"ipv4addr", "network_view"}
+	case "ipv6fixedaddress":
+		keys = []string{"ipv6addr", "ipv6prefix", "network_view"}
+	case "zone_auth", "zone_delegated", "zone_forward":
+		keys = []string{"fqdn", "view"}
+	default:
+		return ""
+	}
+	for _, ref := range f.order {
+		if ref == ignoreRef || refObjectType(ref) != objType {
+			continue
+		}
+		match := true
+		for _, k := range keys {
+			if fmt.Sprint(f.objects[ref][k]) != fmt.Sprint(obj[k]) {
+				match = false
+				break
+			}
+		}
+		if match {
+			return ref
+		}
+	}
+	return ""
+}
+
+// resolveFunctions replaces next-available function calls in a request body
+// with the values the grid would allocate for them
+func (f *fakeWAPI) resolveFunctions(objType string, obj map[string]interface{}) error {
+	if fn, ok := obj["network"].(map[string]interface{}); ok && isFakeNetworkType(objType) {
+		cidr, err := f.nextAvailableNetwork(fn)
+		if err != nil {
+			return err
+		}
+		obj["network"] = cidr
+	}
+	if _, ok := obj["ipv4addr"]; ok {
+		ip, err := f.resolveIPFunction(obj["ipv4addr"])
+		if err != nil {
+			return err
+		}
+		obj["ipv4addr"] = ip
+	}
+	if _, ok := obj["ipv6addr"]; ok {
+		ip, err := f.resolveIPFunction(obj["ipv6addr"])
+		if err != nil {
+			return err
+		}
+		obj["ipv6addr"] = ip
+	}
+	if addresses, ok := obj["ipv4addrs"].([]interface{}); ok {
+		for _, a := range addresses {
+			address := a.(map[string]interface{})
+			value := address["ipv4addr"]
+			if value == nil && address["_object_function"] != nil {
+				value = address
+			}
+			ip, err := f.resolveIPFunction(value)
+			if err != nil {
+				return err
+			}
+			address["ipv4addr"] = ip
+		}
+	}
+	if addresses, ok := obj["ipv6addrs"].([]interface{}); ok {
+		for _, a := range addresses {
+			address := a.(map[string]interface{})
+			ip, err := f.resolveIPFunction(address["ipv6addr"])
+			if err != nil {
+				return err
+			}
+			address["ipv6addr"] = ip
+		}
+	}
+	return nil
+}
+
+func (f *fakeWAPI) resolveIPFunction(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		if !strings.HasPrefix(v, "func:nextavailableip:") {
+			return v, nil
+		}
+		target := strings.TrimPrefix(v, "func:nextavailableip:")
+		target = strings.Split(target, ",")[0]
+		if strings.Contains(target, "-") {
+			bounds := strings.SplitN(target, "-", 2)
+			ips, err := f.nextAvailableIPs("", 1, nil, bounds)
+			if err != nil {
+				return "", err
+			}
+			return ips[0], nil
+		}
+		ips, err := f.nextAvailableIPs(target, 1, nil, nil)
+		if err != nil {
+			return "", err
+		}
+		return ips[0], nil
+	case map[string]interface{}:
+		params, _ := v["_object_parameters"].(map[string]interface{})
+		ips, err := f.nextAvailableIPs(fmt.Sprint(params["network"]), 1, nil, nil)
+		if err != nil {
+			return "", err
+		}
+		return ips[0], nil
+	}
+	return fmt.Sprint(value), nil
+}
+
+// nextAvailableIPs returns num unused host addresses from cidr, or from the
+// inclusive [start, end] bounds when supplied
+func (f *fakeWAPI) nextAvailableIPs(cidr string, num int, exclude []string, bounds []string) ([]string, error) {
+	var first, last netip.Addr
+	if bounds != nil {
+		first = netip.MustParseAddr(bounds[0])
+		last = netip.MustParseAddr(bounds[1])
+	} else {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid network %s", cidr)
+		}
+		if f.findNetwork(prefix.Masked().String()) == nil {
+			return nil, fmt.Errorf("Cannot find network %s", cidr)
+		}
+		first = prefix.Masked().Addr().Next()
+		last = lastAddr(prefix.Masked()).Prev()
+	}
+	used := f.usedAddresses()
+	var ips []string
+	for ip := first; ip.IsValid() && ip.Compare(last) <= 0 && len(ips) < num; ip = ip.Next() {
+		if _, ok := used[ip.String()]; ok || Contains(exclude, ip.String()) {
+			continue
+		}
+		ips = append(ips, ip.String())
+	}
+	if len(ips) < num {
+		return nil, fmt.Errorf("Cannot allocate %d free addresses from %s", num, cidr)
+	}
+	return ips, nil
+}
+
+func (f *fakeWAPI) nextAvailableNetwork(fn map[string]interface{}) (string, error) {
+	if fn["_object_function"] != "next_available_network" {
+		return "", fmt.Errorf("Unsupported object function %v", fn["_object_function"])
+	}
+	objType := fmt.Sprint(fn["_object"])
+	params, _ := fn["_object_parameters"].(map[string]interface{})
+	query := url.Values{}
+	for k, v := range params {
+		query.Set(k, fmt.Sprint(v))
+	}
+	var parent map[string]interface{}
+	for _, ref := range f.order {
+		if refObjectType(ref) != objType {
+			continue
+		}
+		if matched, _ := matchesQuery(f.objects[ref], query); matched {
+			if parent != nil {
+				return "", fmt.Errorf("Multiple %s objects match %v", objType, params)
+			}
+			parent = f.objects[ref]
+		}
+	}
+	if parent == nil {
+		return "", fmt.Errorf("Cannot find %s matching %v", objType, params)
+	}
+	fnParams, _ := fn["_parameters"].(map[string]interface{})
+	size, _ := fnParams["cidr"].(float64)
+	parentPrefix := netip.MustParsePrefix(fmt.Sprint(parent["network"]))
+	if int(size) < parentPrefix.Bits() || int(size) > parentPrefix.Addr().BitLen() {
+		return "", fmt.Errorf("Invalid prefix length %d for %s", int(size), parentPrefix)
+	}
+
+	var existing []netip.Prefix
+	for _, ref := range f.order {
+		t := refObjectType(ref)
+		if !isFakeNetworkType(t) || ref == parent["_ref"] {
+			continue
+		}
+		// only networks nested inside the parent occupy its address space
+		prefix := netip.MustParsePrefix(fmt.Sprint(f.objects[ref]["network"]))
+		if prefix.Bits() > parentPrefix.Bits() && parentPrefix.Contains(prefix.Addr()) {
+			existing = append(existing, prefix)
+		}
+	}
+	step := new(big.Int).Lsh(big.NewInt(1), uint(parentPrefix.Addr().BitLen()-int(size)))
+	for candidate := netip.PrefixFrom(parentPrefix.Addr(), int(size)); candidate.IsValid() && parentPrefix.Contains(candidate.Addr()); {
+		overlap := false
+		for _, p := range existing {
+			if p.Overlaps(candidate) {
+				overlap = true
+				break
+			}
+		}
+		if !overlap {
+			return candidate.String(), nil
+		}
+		next, ok := addToAddr(candidate.Addr(), step)
+		if !ok {
+			break
+		}
+		candidate = netip.PrefixFrom(next, int(size))
+	}
+	return "", fmt.Errorf("Cannot find a free /%d network in %s", int(size), parentPrefix)
+}
+
+// isFakeNetworkType reports whether objects of objType occupy address space
+// that next_available_network must not hand out again
+func isFakeNetworkType(objType string) bool {
+	switch objType {
+	case "network", "networkcontainer", "ipv6network", "ipv6networkcontainer":
+		return true
+	}
+	return false
+}
+
+func isFakeDHCPNetworkType(objType string) bool {
+	return objType == "network" || objType == "ipv6network"
+}
+
+// parentContainer returns the smallest network container in the view holding
+// cidr, or "/" when the network sits at the top of the view
+func (f *fakeWAPI) parentContainer(cidr string, networkView string) string {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return "/"
+	}
+	parent := "/"
+	parentBits := -1
+	for _, ref := range f.order {
+		obj := f.objects[ref]
+		if refObjectType(ref) != "networkcontainer" || fmt.Sprint(obj["network_view"]) != networkView {
+			continue
+		}
+		container, err := netip.ParsePrefix(fmt.Sprint(obj["network"]))
+		if err != nil || container.Bits() >= prefix.Bits() || !container.Contains(prefix.Addr()) {
+			continue
+		}
+		if container.Bits() > parentBits {
+			parent = container.String()
+			parentBits = container.Bits()
+		}
+	}
+	return parent
+}
+
+func (f *fakeWAPI) findNetwork(cidr string) map[string]interface{} {
+	for _, ref := range f.order {
+		if isFakeDHCPNetworkType(refObjectType(ref)) && f.objects[ref]["network"] == cidr {
+			return f.objects[ref]
+		}
+	}
+	return nil
+}
+
+func (f *fakeWAPI) containingNetwork(ip string) string {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return ""
+	}
+	for _, ref := range f.order {
+		if !isFakeDHCPNetworkType(refObjectType(ref)) {
+			continue
+		}
+		prefix, err := netip.ParsePrefix(fmt.Sprint(f.objects[ref]["network"]))
+		if err == nil && prefix.Contains(addr) {
+			return prefix.String()
+		}
+	}
+	return ""
+}
+
+// usedAddresses maps every address referenced by an object to those objects
+func (f *fakeWAPI) usedAddresses() map[string][]map[string]interface{} {
+	used := make(map[string][]map[string]interface{})
+	for _, ref := range f.order {
+		obj := f.objects[ref]
+		switch refObjectType(ref) {
+		case "fixedaddress", "record:a", "record:ptr":
+			if ip, ok := obj["ipv4addr"].(string); ok {
+				used[ip] = append(used[ip], obj)
+			}
+		case "ipv6fixedaddress":
+			if ip, ok := obj["ipv6addr"].(string); ok {
+				used[ip] = append(used[ip], obj)
+			}
+		case "record:host":
+			addresses, _ := obj["ipv4addrs"].([]interface{})
+			for _, a := range addresses {
+				ip := fmt.Sprint(a.(map[string]interface{})["ipv4addr"])
+				used[ip] = append(used[ip], obj)
+			}
+			ipv6Addresses, _ := obj["ipv6addrs"].([]interface{})
+			for _, a := range ipv6Addresses {
+				ip := fmt.Sprint(a.(map[string]interface{})["ipv6addr"])
+				used[ip] = append(used[ip], obj)
+			}
+		}
+	}
+	return used
+}
+
+// allRecords synthesizes the allrecords view of every DNS record, listing
+// each host record address separately. Like the real WAPI, record holds the
+// reference of the record object rather than the object itself
+func (f *fakeWAPI) allRecords() []map[string]interface{} {
+	var records []map[string]interface{}
+	for _, ref := range f.order {
+		obj := f.objects[ref]
+		objType := refObjectType(ref)
+		if !strings.HasPrefix(objType, "record:") {
+			continue
+		}
+		zone := fmt.Sprint(obj["zone"])
+		name := strings.TrimSuffix(fmt.Sprint(obj["name"]), "."+zone)
+		if name == zone {
+			name = ""
+		}
+		record := map[string]interface{}{
+			"name":    name,
+			"type":    objType,
+			"view":    obj["view"],
+			"zone":    zone,
+			"comment": obj["comment"],
+			"disable": obj["disable"] == true,
+		}
+		if obj["use_ttl"] == true {
+			record["ttl"] = obj["ttl"]
+		}
+		switch objType {
+		case "record:host":
+			for _, key := range []string{"ipv4addr", "ipv6addr"} {
+				addresses, _ := obj[key+"s"].([]interface{})
+				for _, a := range addresses {
+					address := a.(map[string]interface{})
+					hostRecord := copyObject(record)
+					hostRecord["_ref"] = fmt.Sprintf("allrecords/ZmFrZS5%s:%s/%s", refID(ref), name, address[key])
+					hostRecord["type"] = "record:host_" + key
+					hostRecord["address"] = address[key]
+					hostRecord["record"] = address["_ref"]
+					records = append(records, hostRecord)
+				}
+			}
+			continue
+		case "record:a":
+			record["address"] = obj["ipv4addr"]
+		case "record:aaaa":
+			record["address"] = obj["ipv6addr"]
+		}
+		record["_ref"] = fmt.Sprintf("allrecords/ZmFrZS5%s:%s", refID(ref), name)
+		record["record"] = ref
+		records = append(records, record)
+	}
+	return records
+}
+
+// ipv4Addresses synthesizes ipv4address objects for the queried network, or
+// for every network overlapping a queried ip_address range
+func (f *fakeWAPI) ipv4Addresses(query url.Values) ([]map[string]interface{}, error) {
+	if query.Get("network") == "" && query.Get("ip_address") == "" && query.Get("ip_address>") != "" && query.Get("ip_address<") != "" {
+		var addresses []map[string]interface{}
+		for _, ref := range f.order {
+			if refObjectType(ref) != "network" {
+				continue
+			}
+			prefix, err := netip.ParsePrefix(fmt.Sprint(f.objects[ref]["network"]))
+			if err != nil || !ipWithinBounds(prefix.Masked().Addr(), query.Get("ip_address>"), query.Get("ip_address<")) {
+				continue
+			}
+			networkAddresses, err := f.ipv4Addresses(url.Values{"network": {prefix.String()}})
+			if err != nil {
+				return nil, err
+			}
+			addresses = append(addresses, networkAddresses...)
+		}
+		return addresses, nil
+	}
+	cidr := query.Get("network")
+	if cidr == "" {
+		if ip := query.Get("ip_address"); ip != "" {
+			cidr = f.containingNetwork(ip)
+		}
+	}
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("ipv4address searches require a network or ip_address inside a known network")
+	}
+	if prefix.Bits() < 16 {
+		return nil, fmt.Errorf("Network %s is too large to enumerate", cidr)
+	}
+	networkView := "default"
+	if network := f.findNetwork(prefix.Masked().String()); network != nil {
+		networkView = fmt.Sprint(network["network_view"])
+	}
+
+	used := f.usedAddresses()
+	var ranges []map[string]interface{}
+	for _, ref := range f.order {
+		if refObjectType(ref) == "range" {
+			ranges = append(ranges, f.objects[ref])
+		}
+	}
+
+	var addresses []map[string]interface{}
+	first := prefix.Masked().Addr()
+	last := lastAddr(prefix.Masked())
+	for ip := first; ip.IsValid() && ip.Compare(last) <= 0; ip = ip.Next() {
+		address := map[string]interface{}{
+			"_ref":         fmt.Sprintf("ipv4address/ZmFrZS5pcA:%s", ip),
+			"ip_address":   ip.String(),
+			"network":      prefix.Masked().String(),
+			"network_view": networkView,
+			"status":       "UNUSED",
+			"names":        []interface{}{},
+			"objects":      []interface{}{},
+			"types":        []interface{}{},
+			"usage":        []interface{}{},
+		}
+		var types, usage, names, objects []interface{}
+		switch ip {
+		case first:
+			types = append(types, "NETWORK")
+		case last:
+			types = append(types, "BROADCAST")
+		}
+		for _, r := range ranges {
+			if ipWithinBounds(ip, fmt.Sprint(r["start_addr"]), fmt.Sprint(r["end_addr"])) {
+				types = append(types, "DHCP_RANGE")
+			}
+		}
+		for _, obj := range used[ip.String()] {
+			objType := refObjectType(fmt.Sprint(obj["_ref"]))
+			objects = append(objects, obj["_ref"])
+			switch objType {
+			case "fixedaddress":
+				types = append(types, "FA")
+				usage = append(usage, "DHCP")
+				if mac, ok := obj["mac"]; ok {
+					address["mac_address"] = mac
+				}
+			case "record:host":
+				types = append(types, "HOST")
+				usage = append(usage, "DNS")
+			case "record:a":
+				types = append(types, "A")
+				usage = append(usage, "DNS")
+			case "record:ptr":
+				types = append(types, "PTR")
+				usage = append(usage, "DNS")
+			}
+			if name, ok := obj["name"]; ok {
+				names = append(names, name)
+			}
+		}
+		if len(types) > 0 {
+			address["types"] = types
+		}
+		if len(objects) > 0 || ip == first || ip == last {
+			address["status"] = "USED"
+		}
+		if names != nil {
+			address["names"] = names
+		}
+		if objects != nil {
+			address["objects"] = objects
+		}
+		if usage != nil {
+			address["usage"] = usage
+		}
+		address["is_conflict"] = false
+		address["conflict_types"] = []interface{}{}
+		if data, ok := f.discovered[ip.String()]; ok {
+			address["discovered_data"] = copyObject(data)
+			if mac, ok := address["mac_address"]; ok && mac != data["mac_address"] {
+				address["is_conflict"] = true
+				address["conflict_types"] = []interface{}{"MAC_ADDRESS"}
+			}
+			// Discovered addresses without an object are in use but unmanaged
+			if objects == nil {
+				address["status"] = "USED"
+				address["types"] = append(types, "UNMANAGED")
+			}
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
+
+// ipamStatistics synthesizes ipam:statistics objects for every network and
+// network container. Network utilization counts used host addresses while
+// container utilization counts the address space allocated to child networks
+func (f *fakeWAPI) ipamStatistics() ([]map[string]interface{}, error) {
+	var statistics []map[string]interface{}
+	for _, ref := range f.order {
+		objType := refObjectType(ref)
+		if objType != "network" && objType != "networkcontainer" {
+			continue
+		}
+		obj := f.objects[ref]
+		prefix, err := netip.ParsePrefix(fmt.Sprint(obj["network"]))
+		if err != nil {
+			return nil, err
+		}
+		addresses, err := f.ipv4Addresses(url.Values{"network": {prefix.String()}})
+		if err != nil {
+			return nil, err
+		}
+		size := 1 << (32 - prefix.Bits())
+		used, unmanaged, conflicts := 0, 0, 0
+		for _, address := range addresses {
+			types := fmt.Sprint(address["types"])
+			if address["status"] == "USED" && !strings.Contains(types, "NETWORK") && !strings.Contains(types, "BROADCAST") {
+				used++
+			}
+			if strings.Contains(types, "UNMANAGED") {
+				unmanaged++
+			}
+			if address["is_conflict"] == true {
+				conflicts++
+			}
+		}
+		total := size - 2
+		if objType == "networkcontainer" {
+			total, used = size, 0
+			for _, childRef := range f.order {
+				child := f.objects[childRef]
+				childType := refObjectType(childRef)
+				if (childType == "network" || childType == "networkcontainer") && child["network_container"] == prefix.String() && child["network_view"] == obj["network_view"] {
+					childPrefix, _ := netip.ParsePrefix(fmt.Sprint(child["network"]))
+					used += 1 << (32 - childPrefix.Bits())
+				}
+			}
+		}
+		statistics = append(statistics, map[string]interface{}{
+			"_ref":            fmt.Sprintf("ipam:statistics/ZmFrZS5%s:%s/%s", refID(ref), obj["network_view"], prefix),
+			"cidr":            prefix.Bits(),
+			"network":         prefix.Addr().String(),
+			"network_view":    obj["network_view"],
+			"conflict_count":  conflicts,
+			"unmanaged_count": unmanaged,
+			"utilization":     used * 1000 / total,
+		})
+	}
+	return statistics, nil
+}
+
+// rangeStatistics returns a copy of a range with its DHCP statistics, counting
+// fixed addresses inside the range as static hosts
+func (f *fakeWAPI) rangeStatistics(obj map[string]interface{}) map[string]interface{} {
+	ret := copyObject(obj)
+	start, err := netip.ParseAddr(fmt.Sprint(obj["start_addr"]))
+	if err != nil {
+		return ret
+	}
+	end, err := netip.ParseAddr(fmt.Sprint(obj["end_addr"]))
+	if err != nil {
+		return ret
+	}
+	total := 0
+	for ip := start; ip.IsValid() && ip.Compare(end) <= 0; ip = ip.Next() {
+		total++
+	}
+	static := 0
+	for _, ref := range f.order {
+		if refObjectType(ref) != "fixedaddress" {
+			continue
+		}
+		ip, err := netip.ParseAddr(fmt.Sprint(f.objects[ref]["ipv4addr"]))
+		if err == nil && ipWithinBounds(ip, start.String(), end.String()) {
+			static++
+		}
+	}
+	ret["total_hosts"] = total
+	ret["static_hosts"] = static
+	ret["dynamic_hosts"] = 0
+	ret["dhcp_utilization"] = static * 1000 / total
+	return ret
+}
+
+// matchesQuery applies WAPI search arguments (field=value, field~=regex,
+// field<=, field>= and *EA=value) to obj
+func matchesQuery(obj map[string]interface{}, query url.Values) (bool, error) {
+	for key, values := range query {
+		if strings.HasPrefix(key, "_") {
+			continue
+		}
+		value := values[0]
+		modifier := ""
+		field := key
+		for _, m := range []string{"~", "<", ">", ":"} {
+			if strings.HasSuffix(field, m) {
+				modifier = m
+				field = strings.TrimSuffix(field, m)
+				break
+			}
+		}
+		var actual []string
+		if strings.HasPrefix(field, "*") {
+			eas, _ := obj["extattrs"].(map[string]interface{})
+			if ea, ok := eas[strings.TrimPrefix(field, "*")].(map[string]interface{}); ok {
+				actual = append(actual, fmt.Sprint(ea["value"]))
+			}
+		} else {
+			switch v := obj[field].(type) {
+			case nil:
+				if field == "ipv4addr" {
+					addresses, _ := obj["ipv4addrs"].([]interface{})
+					for _, a := range addresses {
+						actual = append(actual, fmt.Sprint(a.(map[string]interface{})["ipv4addr"]))
+					}
+				}
+			case []interface{}:
+				for _, item := range v {
+					actual = append(actual, fmt.Sprint(item))
+				}
+			default:
+				actual = append(actual, fmt.Sprint(v))
+			}
+		}
+		matched := false
+		for _, a := range actual {
+			switch modifier {
+			case "~":
+				re, err := regexp.Compile(value)
+				if err != nil {
+					return false, fmt.Errorf("Invalid regular expression %s", value)
+				}
+				matched = re.MatchString(a)
+			case ":":
+				matched = strings.EqualFold(a, value)
+			case "<":
+				matched = compareValues(a, value) <= 0
+			case ">":
+				matched = compareValues(a, value) >= 0
+			default:
+				matched = a == value
+			}
+			if matched {
+				break
+			}
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func compareValues(a string, b string) int {
+	if ipA, err := netip.ParseAddr(a); err == nil {
+		if ipB, err := netip.ParseAddr(b); err == nil {
+			return ipA.Compare(ipB)
+		}
+	}
+	if intA, err := strconv.Atoi(a); err == nil {
+		if intB, err := strconv.Atoi(b); err == nil {
+			return intA - intB
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+// returnFields trims obj down to the fields requested with _return_fields
+func returnFields(obj map[string]interface{}, query url.Values) map[string]interface{} {
+	fields := query.Get("_return_fields")
+	if fields == "" {
+		return copyObject(obj)
+	}
+	ret := map[string]interface{}{
+		"_ref": obj["_ref"],
+	}
+	for _, field := range strings.Split(fields, ",") {
+		field = strings.Split(field, ".")[0]
+		if v, ok := obj[field]; ok {
+			ret[field] = v
+		}
+	}
+	return copyObject(ret)
+}
+
+func refObjectType(ref string) string {
+	return strings.SplitN(ref, "/", 2)[0]
+}
+
+func refID(ref string) string {
+	id := strings.SplitN(ref, "/", 2)[1]
+	id = strings.SplitN(id, ":", 2)[0]
+	return strings.TrimPrefix(id, "ZmFrZS5")
+}
+
+func stripEAOperations(value interface{}) interface{} {
+	ea, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+	return map[string]interface{}{
+		"value": ea["value"],
+	}
+}
+
+// canonicalIPv6 rewrites the IPv6 addresses and networks of obj in their
+// canonical form, as the grid does
+func canonicalIPv6(obj map[string]interface{}) {
+	for _, key := range []string{"ipv6addr", "ipv6prefix"} {
+		if addr, err := netip.ParseAddr(fmt.Sprint(obj[key])); err == nil && addr.Is6() {
+			obj[key] = addr.String()
+		}
+	}
+	if prefix, err := netip.ParsePrefix(fmt.Sprint(obj["network"])); err == nil && prefix.Addr().Is6() {
+		obj["network"] = prefix.String()
+	}
+}
+
+// fakeGridZoneTimers are the grid's SOA timers
+var fakeGridZoneTimers = map[string]interface{}{
+	"soa_default_ttl":  28800,
+	"soa_expire":       2419200,
+	"soa_negative_ttl": 900,
+	"soa_refresh":      10800,
+	"soa_retry":        3600,
+}
+
+func setDefault(obj map[string]interface{}, key string, value interface{}) {
+	if v, ok := obj[key]; !ok || v == "" || v == nil {
+		obj[key] = value
+	}
+}
+
+func zoneFromName(name string) string {
+	parts := strings.SplitN(name, ".", 2)
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[1]
+}
+
+func ipWithinBounds(ip netip.Addr, start string, end string) bool {
+	startAddr, err := netip.ParseAddr(start)
+	if err != nil {
+		return false
+	}
+	endAddr, err := netip.ParseAddr(end)
+	if err != nil {
+		return false
+	}
+	return ip.Compare(startAddr) >= 0 && ip.Compare(endAddr) <= 0
+}
+
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	bytes := prefix.Addr().AsSlice()
+	for bit := prefix.Bits(); bit < len(bytes)*8; bit++ {
+		bytes[bit/8] |= 1 << (7 - uint(bit%8))
+	}
+	addr, _ := netip.AddrFromSlice(bytes)
+	return addr
+}
+
+func addToAddr(addr netip.Addr, n *big.Int) (netip.Addr, bool) {
+	value := new(big.Int).SetBytes(addr.AsSlice())
+	value.Add(value, n)
+	size := len(addr.AsSlice())
+	raw := value.Bytes()
+	if len(raw) > size {
+		return netip.Addr{}, false
+	}
+	bytes := make([]byte, size)
+	copy(bytes[size-len(raw):], raw)
+	next, ok := netip.AddrFromSlice(bytes)
+	return next, ok
+}
+
+func copyObject(obj map[string]interface{}) map[string]interface{} {
+	var ret map[string]interface{}
+	raw, _ := json.Marshal(obj)
+	json.Unmarshal(raw, &ret)
+	return ret
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeWAPIError(w http.ResponseWriter, status int, code string, text string) {
+	writeJSON(w, status, map[string]interface{}{
+		"Error": fmt.Sprintf("AdmConProtoError: %s", text),
+		"code":  code,
+		"text":  text,
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, ref string) {
+	writeWAPIError(w, http.StatusNotFound, "Client.Ibap.Data.NotFound", fmt.Sprintf("Reference %s not found", ref))
+}
+
+var testUnitProviders = map[string]func() (*schema.Provider, error){
+	"infoblox": func() (*schema.Provider, error) {
+		return Provider(), nil
+	},
+}
+
+// testUnitPreCheck skips resource.UnitTest cases when no terraform CLI is
+// available to the test harness, or fails them when TF_UNIT_REQUIRE_TERRAFORM
+// is set so CI can not silently skip them
+func testUnitPreCheck(t *testing.T) {
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" || os.Getenv("TF_ACC_TERRAFORM_VERSION") != "" {
+		return
+	}
+	if _, err := exec.LookPath("terraform"); err != nil {
+		if os.Getenv("TF_UNIT_REQUIRE_TERRAFORM") != "" {
+			t.Fatal("terraform CLI not found in PATH; set TF_ACC_TERRAFORM_PATH to run unit tests against the fake WAPI")
+		}
+		t.Skip("terraform CLI not found in PATH; set TF_ACC_TERRAFORM_PATH to run unit tests against the fake WAPI")
+	}
+}
+
+// testUnitSteps runs the steps built for the provider configuration of a fake
+// WAPI server as a unit test
+func testUnitSteps(t *testing.T, steps func(providerConfig string) []resource.TestStep) {
+	fake := newFakeWAPI(t)
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testUnitPreCheck(t) },
+		ProviderFactories: testUnitProviders,
+		Steps:             steps(fake.providerConfig(testUnitOrchestratorEAs)),
+	})
+}
+
+func TestFakeWAPIAllocation(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+
+	container := infoblox.NetworkContainer{
+		CIDR: "10.20.0.0/16",
+	}
+	if err := client.CreateContainer(&container); err != nil {
+		t.Fatal(err)
+	}
+	network, err := client.CreateNetworkFromContainer(&infoblox.NetworkFromContainer{
+		Network: infoblox.NetworkContainerFunction{
+			Function:         "next_available_network",
+			ResultField:      "networks",
+			Object:           "networkcontainer",
+			ObjectParameters: map[string]string{"network": container.CIDR},
+			Parameters:       map[string]int{"cidr": 24},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if network.CIDR != "10.20.0.0/24" {
+		t.Fatalf("expected first /24 of container but got %s", network.CIDR)
+	}
+
+	fixedAddress := infoblox.FixedAddress{
+		IPAddress:   "func:nextavailableip:10.20.0.0/24",
+		MatchClient: "RESERVED",
+	}
+	if err := client.CreateFixedAddress(&fixedAddress); err != nil {
+		t.Fatal(err)
+	}
+	if fixedAddress.IPAddress != "10.20.0.1" {
+		t.Fatalf("expected first host address but got %s", fixedAddress.IPAddress)
+	}
+
+	addresses, err := client.GetSequentialAddressRange(infoblox.AddressQuery{
+		CIDR:  network.CIDR,
+		Count: 3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if (*addresses)[0].IPAddress != "10.20.0.2" || (*addresses)[2].IPAddress != "10.20.0.4" {
+		t.Fatalf("unexpected sequential block %+v", *addresses)
+	}
+
+	if _, err := client.GetNetworkByRef(network.Ref+"missing", nil); !isNotFoundError(err) {
+		t.Fatalf("expected not found error but got %v", err)
+	}
+
+	// Unpaged searches fail rather than return a partial result set
+	for _, cidr := range []string{"10.20.1.0/24", "10.20.2.0/24"} {
+		fake.create("network", map[string]interface{}{
+			"network": cidr,
+		})
+	}
+	if _, err := client.GetNetworkByQuery(map[string]string{"network_view": "default"}); err == nil || !strings.Contains(err.Error(), "Result set too large") {
+		t.Fatalf("expected an unpaged search over max results to fail, got %v", err)
+	}
+	networks, err := client.GetWAPIObjectsByQuery("network", map[string]string{"_max_results": "-2"}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(networks) != 2 {
+		t.Fatalf("expected a negative max results to truncate the search, found %d networks", len(networks))
+	}
+}
+
+const testUnitOrchestratorEAs = `
+    orchestrator_extensible_attributes = {
+      Orchestrator = jsonencode({
+        value = "Terraform",
+        type  = "ENUM"
+      })
+    }
+`
+
+// testUnitCheckDisappears deletes the object behind resourceName from the fake
+// grid so the following plan must re-create it
+func testUnitCheckDisappears(fake *fakeWAPI, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+		if _, ok := fake.lookup(rs.Primary.ID); !ok {
+			return fmt.Errorf("Resource: %s has no object in the fake grid", resourceName)
+		}
+		fake.remove(rs.Primary.ID)
+		return nil
+	}
+}
+
+// testUnitRawConfigState returns state, or an empty state for a create,
+// carrying config as its raw configuration the way terraform plans resources
+func testUnitRawConfigState(t *testing.T, r *schema.Resource, state *terraform.InstanceState, config map[string]interface{}) *terraform.InstanceState {
+	t.Helper()
+	raw, err := json.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state == nil {
+		state = &terraform.InstanceState{}
+	}
+	state.RawConfig, err = ctyjson.Unmarshal(raw, r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return state
+}
+
+// testUnitPlanCreate plans config for a new resource the way terraform does,
+// including the raw configuration, and returns the resource data a create is
+// applied with
+func testUnitPlanCreate(t *testing.T, r *schema.Resource, config map[string]interface{}, meta interface{}) *schema.ResourceData {
+	t.Helper()
+	state := testUnitRawConfigState(t, r, nil, config)
+	diff, err := r.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(config), meta)
+	if err != nil {
+		t.Fatalf("plan: %s", err)
+	}
+	data, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// testUnitPlanUpdate plans config against the state of d the way terraform
+// does, including the raw configuration, and returns the resource data an
+// update is applied with
+func testUnitPlanUpdate(t *testing.T, r *schema.Resource, d *schema.ResourceData, config map[string]interface{}, meta interface{}) *schema.ResourceData {
+	t.Helper()
+	state := testUnitRawConfigState(t, r, d.State(), config)
+	diff, err := r.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(config), meta)
+	if err != nil {
+		t.Fatalf("plan: %s", err)
+	}
+	if diff.RequiresNew() {
+		t.Fatalf("plan: expected an update in place, found %v", diff.Attributes)
+	}
+	data, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"reflect"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/techBeck03/infoblox-go-sdk"
 	"github.com/tidwall/gjson"
@@ -21,6 +23,52 @@ func newBool(b bool) *bool {
 	return &b
 }
 
+func newInt(i int) *int {
+	return &i
+}
+
+func newString(s string) *string {
+	return &s
+}
+
+// stringValue returns the string s points to, or an empty string when unset
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// rawConfigReader is implemented by both schema.ResourceData and
+// schema.ResourceDiff
+type rawConfigReader interface {
+	GetRawConfig() cty.Value
+}
+
+// configuredInt returns the configured value of a top level integer argument
+// and whether it is set. Unlike GetOk an explicit 0 is reported as set
+func configuredInt(d rawConfigReader, key string) (int, bool) {
+	config := d.GetRawConfig()
+	if !config.IsKnown() || config.IsNull() {
+		return 0, false
+	}
+	value := config.GetAttr(key)
+	if !value.IsKnown() || value.IsNull() {
+		return 0, false
+	}
+	i, _ := value.AsBigFloat().Int64()
+	return int(i), true
+}
+
+// newStringSlice converts a list of strings read from a schema set or list
+func newStringSlice(list []interface{}) *[]string {
+	s := []string{}
+	for _, v := range list {
+		s = append(s, v.(string))
+	}
+	return &s
+}
+
 func newExtensibleAttribute(ea infoblox.ExtensibleAttribute) *infoblox.ExtensibleAttribute {
 	return &ea
 }
@@ -44,6 +92,15 @@ func Keys(m map[string]interface{}) []string {
 	return keys
 }
 
+// isNotFoundError tells whether err is a WAPI response for a missing object
+func isNotFoundError(err error) bool {
+	var responseError *infoblox.ResponseError
+	if errors.As(err, &responseError) {
+		return responseError.StatusCode == http.StatusNotFound
+	}
+	return false
+}
+
 func createExtensibleAttributesFromJSON(eaMap map[string]interface{}) (eas infoblox.ExtensibleAttribute, err error) {
 	eas = infoblox.ExtensibleAttribute{}
 	defer func() {
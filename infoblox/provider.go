@@ -2,9 +2,11 @@ package infoblox
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	infoblox "github.com/techBeck03/infoblox-go-sdk"
 )
 
@@ -49,6 +51,27 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("INFOBLOX_DISABLE_TLS", false),
 				Description: "Disable tls verification",
 			},
+			"max_retries": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("INFOBLOX_MAX_RETRIES", 3),
+				Description:      "Number of times a failed request is retried",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"retry_wait_min": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("INFOBLOX_RETRY_WAIT_MIN", 1),
+				Description:      "Minimum number of seconds to wait before retrying a failed request",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			},
+			"retry_wait_max": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("INFOBLOX_RETRY_WAIT_MAX", 30),
+				Description:      "Maximum number of seconds to wait before retrying a failed request",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			},
 			"orchestrator_extensible_attributes": {
 				Type:             schema.TypeMap,
 				Description:      "Extensible attributes applied to all objects configured by provider",
@@ -62,29 +85,57 @@ func Provider() *schema.Provider {
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"infoblox_container":     resourceContainer(),
-			"infoblox_host_record":   resourceHostRecord(),
-			"infoblox_network":       resourceNetwork(),
-			"infoblox_range":         resourceRange(),
-			"infoblox_fixed_address": resourceFixedAddress(),
-			"infoblox_a_record":      resourceARecord(),
-			"infoblox_cname_record":  resourceCNameRecord(),
-			"infoblox_alias_record":  resourceAliasRecord(),
-			"infoblox_ptr_record":    resourcePtrRecord(),
+			"infoblox_container":          resourceContainer(),
+			"infoblox_host_record":        resourceHostRecord(),
+			"infoblox_network":            resourceNetwork(),
+			"infoblox_range":              resourceRange(),
+			"infoblox_fixed_address":      resourceFixedAddress(),
+			"infoblox_a_record":           resourceARecord(),
+			"infoblox_cname_record":       resourceCNameRecord(),
+			"infoblox_alias_record":       resourceAliasRecord(),
+			"infoblox_ptr_record":         resourcePtrRecord(),
+			"infoblox_ea_definition":      resourceEADefinition(),
+			"infoblox_network_view":       resourceNetworkView(),
+			"infoblox_zone_auth":          resourceZoneAuth(),
+			"infoblox_zone_delegated":     resourceZoneDelegated(),
+			"infoblox_zone_forward":       resourceZoneForward(),
+			"infoblox_dns_view":           resourceDNSView(),
+			"infoblox_aaaa_record":        resourceAAAARecord(),
+			"infoblox_mx_record":          resourceMXRecord(),
+			"infoblox_txt_record":         resourceTXTRecord(),
+			"infoblox_srv_record":         resourceSRVRecord(),
+			"infoblox_ipv6_network":       resourceIPv6Network(),
+			"infoblox_ipv6_container":     resourceIPv6Container(),
+			"infoblox_ipv6_fixed_address": resourceIPv6FixedAddress(),
+			"infoblox_wapi_object":        resourceWAPIObject(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"infoblox_container":                dataSourceContainer(),
 			"infoblox_host_record":              dataSourceHostRecord(),
 			"infoblox_network":                  dataSourceNetwork(),
+			"infoblox_ip_address":               dataSourceIPAddress(),
+			"infoblox_ipam_statistics":          dataSourceIPAMStatistics(),
+			"infoblox_networks":                 dataSourceNetworks(),
+			"infoblox_zone_records":             dataSourceZoneRecords(),
+			"infoblox_wapi_object":              dataSourceWAPIObject(),
 			"infoblox_grid":                     dataSourceGrid(),
 			"infoblox_grid_member":              dataSourceGridMember(),
 			"infoblox_sequential_address_block": dataSourceSequentialAddressBlock(),
+			"infoblox_next_available_ips":       dataSourceNextAvailableIPs(),
 			"infoblox_range":                    dataSourceRange(),
+			"infoblox_dhcp_leases":              dataSourceDHCPLeases(),
 			"infoblox_a_record":                 dataSourceARecord(),
 			"infoblox_cname_record":             dataSourceCNameRecord(),
 			"infoblox_alias_record":             dataSourceAliasRecord(),
 			"infoblox_ptr_record":               dataSourcePtrRecord(),
 			"infoblox_fixed_address":            dataSourceFixedAddress(),
+			"infoblox_ea_definition":            dataSourceEADefinition(),
+			"infoblox_network_view":             dataSourceNetworkView(),
+			"infoblox_dns_view":                 dataSourceDNSView(),
+			"infoblox_aaaa_record":              dataSourceAAAARecord(),
+			"infoblox_mx_record":                dataSourceMXRecord(),
+			"infoblox_txt_record":               dataSourceTXTRecord(),
+			"infoblox_srv_record":               dataSourceSRVRecord(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -97,6 +148,9 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	password := d.Get("password").(string)
 	wapiVersion := d.Get("wapi_version").(string)
 	disableTLS := d.Get("disable_tls_verification").(bool)
+	maxRetries := d.Get("max_retries").(int)
+	retryWaitMin := d.Get("retry_wait_min").(int)
+	retryWaitMax := d.Get("retry_wait_max").(int)
 
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
@@ -108,6 +162,9 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		Password:               password,
 		Version:                wapiVersion,
 		DisableTLSVerification: disableTLS,
+		MaxRetries:             maxRetries,
+		RetryWaitMin:           time.Duration(retryWaitMin) * time.Second,
+		RetryWaitMax:           time.Duration(retryWaitMax) * time.Second,
 	}
 
 	// Check for required provider parameters
@@ -157,5 +214,12 @@ func validate(config infoblox.Config) diag.Diagnostics {
 			Detail:   "Password must be configured for the infoblox provider",
 		})
 	}
+	if config.RetryWaitMax < config.RetryWaitMin {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid provider parameter",
+			Detail:   "retry_wait_max must be greater than or equal to retry_wait_min",
+		})
+	}
 	return diags
 }
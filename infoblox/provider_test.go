@@ -1,11 +1,17 @@
 package infoblox
 
 import (
+	"context"
+	"encoding/json"
 	"net"
+	"net/http"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
 )
 
 var testAccProviders map[string]func() (*schema.Provider, error)
@@ -30,6 +36,295 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
 
+func TestUnitResourceReadRemovesMissingObject(t *testing.T) {
+	fake := newFakeWAPI(t)
+	objectTypes := map[string]string{
+		"infoblox_a_record":      "record:a",
+		"infoblox_alias_record":  "record:alias",
+		"infoblox_cname_record":  "record:cname",
+		"infoblox_container":     "networkcontainer",
+		"infoblox_fixed_address": "fixedaddress",
+		"infoblox_host_record":   "record:host",
+		"infoblox_network":       "network",
+		"infoblox_ptr_record":    "record:ptr",
+		"infoblox_range":         "range",
+	}
+	for name, objectType := range objectTypes {
+		r := Provider().ResourcesMap[name]
+		d := r.TestResourceData()
+		d.SetId(objectType + "/ZmFrZS45OTk:missing/default")
+		diags := r.ReadContext(context.Background(), d, fake.client())
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected error reading missing object: %+v", name, diags)
+		}
+		if d.Id() != "" {
+			t.Fatalf("%s: expected missing object to be removed from state, found id %s", name, d.Id())
+		}
+	}
+}
+
+func testUnitRetryConfig(config *infoblox.Config) {
+	config.MaxRetries = 2
+	config.RetryWaitMin = time.Millisecond
+	config.RetryWaitMax = 5 * time.Millisecond
+}
+
+func TestUnitClientRetry(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client(testUnitRetryConfig)
+	ref := fake.create("network", map[string]interface{}{
+		"network": "10.90.0.0/24",
+	})
+
+	// Reads are retried through transient failures
+	fake.fail(http.MethodGet, http.StatusServiceUnavailable, "Service Unavailable", 2)
+	if _, err := client.GetNetworkByRef(ref, nil); err != nil {
+		t.Fatalf("expected read to succeed after retries: %s", err)
+	}
+	if attempts := fake.attemptCount(http.MethodGet); attempts != 3 {
+		t.Fatalf("expected 3 read attempts, found %d", attempts)
+	}
+
+	// Retries stop once max_retries is exhausted
+	fake.fail(http.MethodGet, http.StatusBadGateway, "Bad Gateway", 3)
+	if _, err := client.GetNetworkByRef(ref, nil); err == nil {
+		t.Fatal("expected read to fail once retries were exhausted")
+	}
+	if attempts := fake.attemptCount(http.MethodGet); attempts != 6 {
+		t.Fatalf("expected 6 read attempts, found %d", attempts)
+	}
+
+	// Proxy error pages are retried like any other gateway error on reads
+	fake.failPage(http.MethodGet, http.StatusServiceUnavailable, "Service Temporarily Unavailable", 1)
+	if _, err := client.GetNetworkByRef(ref, nil); err != nil {
+		t.Fatalf("expected read to succeed after a proxy error page: %s", err)
+	}
+	if attempts := fake.attemptCount(http.MethodGet); attempts != 8 {
+		t.Fatalf("expected 8 read attempts, found %d", attempts)
+	}
+
+	// Errors that are not transient are returned immediately
+	if _, err := client.GetNetworkByRef(ref+"missing", nil); !isNotFoundError(err) {
+		t.Fatalf("expected not found error but got %v", err)
+	}
+	if attempts := fake.attemptCount(http.MethodGet); attempts != 9 {
+		t.Fatalf("expected 9 read attempts, found %d", attempts)
+	}
+}
+
+func TestUnitClientRetryDelete(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client(testUnitRetryConfig)
+	ref := fake.create("network", map[string]interface{}{
+		"network": "10.93.0.0/24",
+	})
+
+	// The first attempt deletes the network but its response is lost, so the
+	// retry finds nothing left to delete
+	fake.drop(http.MethodDelete, 1)
+	request, err := client.CreateJSONRequest(http.MethodDelete, ref, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response := client.Call(request, nil); response != nil {
+		t.Fatalf("expected delete to succeed after a lost response: %s", response.ErrorMessage)
+	}
+	if attempts := fake.attemptCount(http.MethodDelete); attempts != 2 {
+		t.Fatalf("expected 2 delete attempts, found %d", attempts)
+	}
+	if _, ok := fake.lookup(ref); ok {
+		t.Fatalf("expected network %s to be deleted", ref)
+	}
+
+	// A missing object is still reported when the first attempt finds it gone
+	request, err = client.CreateJSONRequest(http.MethodDelete, ref, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response := client.Call(request, nil); response == nil || response.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected not found error but got %v", response)
+	}
+}
+
+func TestUnitClientRetryNextAvailableNetwork(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client(testUnitRetryConfig)
+	fake.create("networkcontainer", map[string]interface{}{
+		"network": "10.94.0.0/16",
+	})
+	fake.create("ipv6networkcontainer", map[string]interface{}{
+		"network": "2001:db8:94::/48",
+	})
+	nextAvailable := func(object string, parent string, cidr int) infoblox.NetworkContainerFunction {
+		return infoblox.NetworkContainerFunction{
+			Function:         "next_available_network",
+			ResultField:      "networks",
+			Object:           object,
+			ObjectParameters: map[string]string{"network": parent},
+			Parameters:       map[string]int{"cidr": cidr},
+		}
+	}
+
+	// Allocating from a container is never repeated after a gateway error
+	for _, test := range []struct {
+		name   string
+		create func() error
+	}{
+		{"container", func() error {
+			_, err := client.CreateContainerFromContainer(&infoblox.NetworkContainerFromContainer{
+				Network: nextAvailable("networkcontainer", "10.94.0.0/16", 20),
+			})
+			return err
+		}},
+		{"network", func() error {
+			_, err := client.CreateNetworkFromContainer(&infoblox.NetworkFromContainer{
+				Network: nextAvailable("networkcontainer", "10.94.0.0/16", 24),
+			})
+			return err
+		}},
+		{"ipv6network", func() error {
+			_, err := client.CreateIPv6NetworkFromContainer(&infoblox.NetworkFromContainer{
+				Network: nextAvailable("ipv6networkcontainer", "2001:db8:94::/48", 64),
+			})
+			return err
+		}},
+	} {
+		attempts := fake.attemptCount(http.MethodPost)
+		fake.fail(http.MethodPost, http.StatusServiceUnavailable, "Service Unavailable", 1)
+		if err := test.create(); err == nil {
+			t.Fatalf("%s: expected next available allocation not to be retried", test.name)
+		}
+		if found := fake.attemptCount(http.MethodPost) - attempts; found != 1 {
+			t.Fatalf("%s: expected 1 create attempt, found %d", test.name, found)
+		}
+		if err := test.create(); err != nil {
+			t.Fatalf("%s: expected allocation to succeed: %s", test.name, err)
+		}
+	}
+}
+
+func TestUnitClientRestartServices(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	gridRef := fake.refs("grid")[0]
+	fake.create("network", map[string]interface{}{
+		"network": "10.92.0.0/24",
+	})
+	member := []interface{}{
+		map[string]interface{}{"hostname": "infoblox1.example.com"},
+	}
+
+	// The DHCP resources restart only their own member, and only if needed
+	for _, test := range []struct {
+		name     string
+		resource *schema.Resource
+		config   map[string]interface{}
+	}{
+		{"network", resourceNetwork(), map[string]interface{}{
+			"cidr": "10.93.0.0/24",
+		}},
+		{"range", resourceRange(), map[string]interface{}{
+			"cidr":          "10.92.0.0/24",
+			"start_address": "10.92.0.10",
+			"end_address":   "10.92.0.20",
+		}},
+		{"fixed_address", resourceFixedAddress(), map[string]interface{}{
+			"ip_address":   "10.92.0.30",
+			"match_client": "RESERVED",
+		}},
+	} {
+		restartCount := len(fake.restartRequests())
+		test.config["grid_ref"] = gridRef
+		test.config["restart_if_needed"] = true
+		test.config["member"] = member
+		d := schema.TestResourceDataRaw(t, test.resource.Schema, test.config)
+		if diags := test.resource.CreateContext(context.Background(), d, client); diags.HasError() {
+			t.Fatalf("%s create: %+v", test.name, diags)
+		}
+		restarts := fake.restartRequests()
+		if len(restarts) != restartCount+1 {
+			t.Fatalf("%s: expected create to restart DHCP, found %v", test.name, restarts)
+		}
+		body, _ := json.Marshal(restarts[restartCount])
+		if string(body) != `{"members":["infoblox1.example.com"],"restart_option":"RESTART_IF_NEEDED","services":["DHCP"]}` {
+			t.Fatalf("%s: unexpected restart request %s", test.name, body)
+		}
+	}
+}
+
+func TestUnitClientRetryNextAvailable(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client(testUnitRetryConfig)
+	fake.create("network", map[string]interface{}{
+		"network": "10.91.0.0/24",
+	})
+
+	// A gateway error may hide an allocation that already happened
+	fake.fail(http.MethodPost, http.StatusServiceUnavailable, "Service Unavailable", 1)
+	fixedAddress := infoblox.FixedAddress{
+		IPAddress:   "func:nextavailableip:10.91.0.0/24",
+		MatchClient: "RESERVED",
+	}
+	if err := client.CreateFixedAddress(&fixedAddress); err == nil {
+		t.Fatal("expected next available allocation not to be retried")
+	}
+	if attempts := fake.attemptCount(http.MethodPost); attempts != 1 {
+		t.Fatalf("expected 1 create attempt, found %d", attempts)
+	}
+
+	// A locked database means nothing was committed
+	fake.fail(http.MethodPost, http.StatusBadRequest, "The database is locked, try again later", 1)
+	fixedAddress = infoblox.FixedAddress{
+		IPAddress:   "func:nextavailableip:10.91.0.0/24",
+		MatchClient: "RESERVED",
+	}
+	if err := client.CreateFixedAddress(&fixedAddress); err != nil {
+		t.Fatalf("expected create to succeed after retry: %s", err)
+	}
+	if attempts := fake.attemptCount(http.MethodPost); attempts != 3 {
+		t.Fatalf("expected 3 create attempts, found %d", attempts)
+	}
+	if fixedAddress.IPAddress != "10.91.0.1" {
+		t.Fatalf("expected first host address but got %s", fixedAddress.IPAddress)
+	}
+
+	// A proxy error page is not a WAPI error, even when it reads as temporary
+	fake.failPage(http.MethodPost, http.StatusServiceUnavailable, "Service Temporarily Unavailable", 1)
+	fixedAddress = infoblox.FixedAddress{
+		IPAddress:   "func:nextavailableip:10.91.0.0/24",
+		MatchClient: "RESERVED",
+	}
+	if err := client.CreateFixedAddress(&fixedAddress); err == nil {
+		t.Fatal("expected next available allocation behind a proxy error page not to be retried")
+	}
+	if attempts := fake.attemptCount(http.MethodPost); attempts != 4 {
+		t.Fatalf("expected 4 create attempts, found %d", attempts)
+	}
+
+	// Updates that allocate an address are not repeated either
+	ref := fake.create("fixedaddress", map[string]interface{}{
+		"ipv4addr":     "10.91.0.100",
+		"match_client": "RESERVED",
+	})
+	fake.fail(http.MethodPut, http.StatusBadGateway, "Bad Gateway", 1)
+	if _, err := client.UpdateFixedAddress(ref, infoblox.FixedAddress{IPAddress: "func:nextavailableip:10.91.0.0/24"}); err == nil {
+		t.Fatal("expected next available update not to be retried")
+	}
+	if attempts := fake.attemptCount(http.MethodPut); attempts != 1 {
+		t.Fatalf("expected 1 update attempt, found %d", attempts)
+	}
+}
+
+// testAccSteps runs the steps built for the provider configuration of the
+// grid in the environment as an acceptance test
+func testAccSteps(t *testing.T, steps func(providerConfig string) []resource.TestStep) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps:             steps(testAccProviderBaseConfig),
+	})
+}
+
 func testAccPreCheck(t *testing.T) {
 	if err := os.Getenv("INFOBLOX_HOSTNAME"); err == "" {
 		t.Fatal("INFOBLOX_HOSTNAME must be set for acceptance tests")
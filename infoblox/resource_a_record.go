@@ -2,6 +2,7 @@ package infoblox
 
 import (
 	"context"
+	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -41,6 +42,7 @@ func resourceARecord() *schema.Resource {
 				Description: "The name for an A record in punycode format.",
 				Computed:    true,
 			},
+			"extensible_attribute": extensibleAttributeBlockSchema(),
 			"extensible_attributes": {
 				Type:             schema.TypeMap,
 				Description:      "Extensible attributes of A record (Values are JSON encoded).",
@@ -146,6 +148,11 @@ func resourceARecordRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 	record, err := client.GetARecordByRef(ref, nil)
 	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] A record %s not found, removing from state", ref)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.FromErr(err)...)
 		return diags
 	}
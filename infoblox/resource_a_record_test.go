@@ -1,11 +1,13 @@
 package infoblox
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/techBeck03/go-ipmath"
 )
@@ -130,3 +132,81 @@ resource "infoblox_a_record" "new"{
 }
 `, aRecordIPAddress, aRecordHostnameUpdate)
 }
+
+func TestUnitInfobloxARecordBasic(t *testing.T) {
+	fake := newFakeWAPI(t)
+	config := func(comment string) string {
+		return fmt.Sprintf(`
+  resource "infoblox_a_record" "new"{
+    ip_address = "10.50.0.10"
+    comment    = "%s"
+    hostname   = "unit-a.example.com"
+    extensible_attributes = {
+      Location = jsonencode({
+        value = "CollegeStation",
+        type  = "STRING"
+      })
+    }
+  }
+`, comment)
+	}
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testUnitPreCheck(t) },
+		ProviderFactories: testUnitProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: composeConfig(fake.providerConfig(testUnitOrchestratorEAs), config("test a record")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInfobloxARecordExists("infoblox_a_record.new"),
+					resource.TestCheckResourceAttr("infoblox_a_record.new", "ip_address", "10.50.0.10"),
+					resource.TestCheckResourceAttr("infoblox_a_record.new", "comment", "test a record"),
+				),
+			},
+			{
+				Config: composeConfig(fake.providerConfig(testUnitOrchestratorEAs), config("test a record update")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_a_record.new", "comment", "test a record update"),
+				),
+			},
+			{
+				Config:             composeConfig(fake.providerConfig(testUnitOrchestratorEAs), config("test a record update")),
+				Check:              testUnitCheckDisappears(fake, "infoblox_a_record.new"),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestUnitResourceARecordCRUD(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	r := resourceARecord()
+	config := map[string]interface{}{
+		"hostname":   "unit-a.example.com",
+		"ip_address": "10.50.0.10",
+		"comment":    "test a record",
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, config)
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	if _, ok := fake.lookup(d.Id()); !ok {
+		t.Fatalf("create: record %s not found in fake grid", d.Id())
+	}
+
+	config["comment"] = "test a record update"
+	d = testUnitPlanUpdate(t, r, d, config, client)
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	if object, _ := fake.lookup(d.Id()); object["comment"] != "test a record update" {
+		t.Fatalf("update: expected comment to be updated, found %v", object["comment"])
+	}
+
+	if diags := r.DeleteContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("delete: %+v", diags)
+	}
+	if _, ok := fake.lookup(d.Id()); ok {
+		t.Fatal("delete: expected the record to be deleted")
+	}
+}
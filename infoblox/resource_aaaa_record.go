@@ -0,0 +1,302 @@
+package infoblox
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+func resourceAAAARecord() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAAAARecordCreate,
+		ReadContext:   resourceAAAARecordRead,
+		UpdateContext: resourceAAAARecordUpdate,
+		DeleteContext: resourceAAAARecordDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customdiff.Sequence(
+			makeEACustomDiff("extensible_attributes"),
+		),
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:             schema.TypeString,
+				Description:      "Comment for the record; maximum 256 characters.",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 256)),
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Description: "Determines if the record is disabled or not. False means that the record is enabled.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"dns_name": {
+				Type:        schema.TypeString,
+				Description: "The name for an AAAA record in punycode format.",
+				Computed:    true,
+			},
+			"extensible_attribute": extensibleAttributeBlockSchema(),
+			"extensible_attributes": {
+				Type:             schema.TypeMap,
+				Description:      "Extensible attributes of AAAA record (Values are JSON encoded).",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateEa,
+				DiffSuppressFunc: eaSuppressDiff,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"hostname": {
+				Type:        schema.TypeString,
+				Description: "Hostname of AAAA record.",
+				Required:    true,
+			},
+			"ip_address": {
+				Type:             schema.TypeString,
+				Description:      "The IPv6 Address of the record.",
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPv6Address),
+				DiffSuppressFunc: ipv6AddressSuppressDiff,
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Description: "Reference id of AAAA record object.",
+				Computed:    true,
+			},
+			"ttl": {
+				Type:             schema.TypeInt,
+				Description:      "The Time To Live (TTL) value for the record. When unset the zone TTL is used.",
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Description: "The name of the DNS view in which the record resides.",
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Description: "The name of the zone in which the record resides.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func convertAAAARecordToResourceData(client *infoblox.Client, d *schema.ResourceData, record *infoblox.AAAARecord) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.Set("ref", record.Ref)
+	d.Set("hostname", record.Hostname)
+	d.Set("dns_name", record.DNSName)
+	d.Set("ip_address", record.IPAddress)
+	d.Set("comment", record.Comment)
+	d.Set("disable", record.Disable)
+	d.Set("view", record.View)
+	d.Set("zone", record.Zone)
+	if record.UseTTL != nil && *record.UseTTL && record.TTL != nil {
+		d.Set("ttl", *record.TTL)
+	} else {
+		d.Set("ttl", nil)
+	}
+
+	eas, err := client.ConvertEAsToJSONString(*record.ExtensibleAttributes)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	} else {
+		d.Set("extensible_attributes", eas)
+	}
+
+	return diags
+}
+
+func convertResourceDataToAAAARecord(client *infoblox.Client, d *schema.ResourceData) (*infoblox.AAAARecord, error) {
+	var record infoblox.AAAARecord
+
+	record.Hostname = d.Get("hostname").(string)
+	record.DNSName = d.Get("dns_name").(string)
+	record.IPAddress = d.Get("ip_address").(string)
+	record.Comment = d.Get("comment").(string)
+	record.Disable = newBool(d.Get("disable").(bool))
+	record.View = d.Get("view").(string)
+	record.Zone = d.Get("zone").(string)
+	if ttl, ok := configuredInt(d, "ttl"); ok {
+		record.TTL = newInt(ttl)
+		record.UseTTL = newBool(true)
+	}
+
+	eaMap := d.Get("extensible_attributes").(map[string]interface{})
+	if len(eaMap) > 0 {
+		eas, err := createExtensibleAttributesFromJSON(eaMap)
+		if err != nil {
+			return &record, err
+		}
+		record.ExtensibleAttributes = &eas
+	}
+
+	if client.OrchestratorEAs != nil && len(*client.OrchestratorEAs) > 0 {
+		if record.ExtensibleAttributes == nil {
+			record.ExtensibleAttributes = &infoblox.ExtensibleAttribute{}
+		}
+		for k, v := range *client.OrchestratorEAs {
+			(*record.ExtensibleAttributes)[k] = v
+		}
+	}
+
+	return &record, nil
+}
+
+func resourceAAAARecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	record, err := client.GetAAAARecordByRef(ref, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] AAAA record %s not found, removing from state", ref)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	check := convertAAAARecordToResourceData(client, d, &record)
+	if check.HasError() {
+		return check
+	}
+
+	d.SetId(record.Ref)
+
+	return diags
+}
+
+func resourceAAAARecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+
+	record, err := convertResourceDataToAAAARecord(client, d)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	err = client.CreateAAAARecord(record)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(record.Ref)
+	return resourceAAAARecordRead(ctx, d, m)
+}
+
+func resourceAAAARecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*infoblox.Client)
+
+	var record infoblox.AAAARecord
+
+	if d.HasChange("hostname") {
+		record.Hostname = d.Get("hostname").(string)
+	}
+	if d.HasChange("dns_name") {
+		record.DNSName = d.Get("dns_name").(string)
+	}
+	if d.HasChange("ip_address") {
+		record.IPAddress = d.Get("ip_address").(string)
+	}
+	if d.HasChange("comment") {
+		record.Comment = d.Get("comment").(string)
+	}
+	if d.HasChange("disable") {
+		record.Disable = newBool(d.Get("disable").(bool))
+	}
+	if d.HasChange("view") {
+		record.View = d.Get("view").(string)
+	}
+	if d.HasChange("zone") {
+		record.Zone = d.Get("zone").(string)
+	}
+	if d.HasChange("ttl") {
+		ttl, ok := configuredInt(d, "ttl")
+		if ok {
+			record.TTL = newInt(ttl)
+		}
+		record.UseTTL = newBool(ok)
+	}
+	if d.HasChange("extensible_attributes") {
+		old, new := d.GetChange("extensible_attributes")
+		oldKeys := Keys(old.(map[string]interface{}))
+		oldEAs, err := createExtensibleAttributesFromJSON(old.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		newKeys := Keys(new.(map[string]interface{}))
+		newEAs, err := createExtensibleAttributesFromJSON(new.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		removeEAs := sliceDiff(oldKeys, newKeys, false)
+		if len(removeEAs) > 0 {
+			record.ExtensibleAttributesRemove = &infoblox.ExtensibleAttribute{}
+			for _, v := range removeEAs {
+				(*record.ExtensibleAttributesRemove)[v] = oldEAs[v]
+			}
+		}
+		for k, v := range newEAs {
+			if !Contains(oldKeys, k) || (Contains(oldKeys, k) && v.Value != oldEAs[k].Value) {
+				if record.ExtensibleAttributesAdd == nil {
+					record.ExtensibleAttributesAdd = &infoblox.ExtensibleAttribute{}
+				}
+				(*record.ExtensibleAttributesAdd)[k] = v
+			}
+		}
+		if client.OrchestratorEAs != nil && len(*client.OrchestratorEAs) > 0 {
+			if record.ExtensibleAttributesAdd == nil {
+				record.ExtensibleAttributesAdd = &infoblox.ExtensibleAttribute{}
+			}
+			for k, v := range *client.OrchestratorEAs {
+				(*record.ExtensibleAttributesAdd)[k] = v
+			}
+		}
+	}
+	changedRecord, err := client.UpdateAAAARecord(d.Id(), record)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	d.SetId(changedRecord.Ref)
+	return resourceAAAARecordRead(ctx, d, m)
+}
+
+func resourceAAAARecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	err := client.DeleteAAAARecord(ref)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
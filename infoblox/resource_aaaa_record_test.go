@@ -0,0 +1,209 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var (
+	aaaaRecordDomainName = os.Getenv("INFOBLOX_DOMAIN")
+)
+
+func TestAccInfobloxAAAARecordBasic(t *testing.T) {
+	testAccSteps(t, func(providerConfig string) []resource.TestStep {
+		return testInfobloxAAAARecordSteps(providerConfig, aaaaRecordDomainName)
+	})
+}
+
+func TestUnitInfobloxAAAARecordBasic(t *testing.T) {
+	testUnitSteps(t, func(providerConfig string) []resource.TestStep {
+		return testInfobloxAAAARecordSteps(providerConfig, "example.com")
+	})
+}
+
+func testInfobloxAAAARecordSteps(providerConfig string, domain string) []resource.TestStep {
+	hostname := fmt.Sprintf("infoblox-test-aaaa.%s", domain)
+	return []resource.TestStep{
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxAAAARecordCreate(hostname)),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxAAAARecordExists("infoblox_aaaa_record.new"),
+				resource.TestCheckResourceAttr("infoblox_aaaa_record.new", "ip_address", "2001:db8::10"),
+				resource.TestCheckResourceAttr("infoblox_aaaa_record.new", "comment", "test aaaa record"),
+				resource.TestCheckResourceAttr("infoblox_aaaa_record.new", "hostname", hostname),
+				resource.TestCheckResourceAttr("infoblox_aaaa_record.new", "ttl", "300"),
+				resource.TestCheckResourceAttr("infoblox_aaaa_record.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation\",\"type\":\"STRING\"}"),
+				resource.TestCheckResourceAttr("infoblox_aaaa_record.new", "extensible_attributes.Orchestrator", "{\"value\":\"Terraform\",\"type\":\"ENUM\"}"),
+				testAccCheckInfobloxAAAARecordExists("data.infoblox_aaaa_record.hostname"),
+				testAccCheckInfobloxAAAARecordExists("data.infoblox_aaaa_record.ip_address"),
+				resource.TestCheckResourceAttr("data.infoblox_aaaa_record.hostname", "ip_address", "2001:db8::10"),
+				resource.TestCheckResourceAttr("data.infoblox_aaaa_record.hostname", "ttl", "300"),
+				resource.TestCheckResourceAttr("data.infoblox_aaaa_record.ip_address", "hostname", hostname),
+			),
+		},
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxAAAARecordUpdate(hostname)),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxAAAARecordExists("infoblox_aaaa_record.new"),
+				resource.TestCheckResourceAttr("infoblox_aaaa_record.new", "ip_address", "2001:db8::11"),
+				resource.TestCheckResourceAttr("infoblox_aaaa_record.new", "comment", "test aaaa record update"),
+				resource.TestCheckNoResourceAttr("infoblox_aaaa_record.new", "ttl"),
+				resource.TestCheckResourceAttr("infoblox_aaaa_record.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation2\",\"type\":\"STRING\"}"),
+			),
+		},
+	}
+}
+
+func TestUnitResourceAAAARecordTTL(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	r := resourceAAAARecord()
+
+	// A ttl of 0 is sent rather than treated as unset
+	d := testUnitPlanCreate(t, r, map[string]interface{}{
+		"hostname":   "unit-aaaa.example.com",
+		"ip_address": "2001:db8::20",
+		"ttl":        0,
+	}, client)
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	record, ok := fake.lookup(d.Id())
+	if !ok {
+		t.Fatalf("create: record %s not found in fake grid", d.Id())
+	}
+	if record["use_ttl"] != true || fmt.Sprint(record["ttl"]) != "0" {
+		t.Fatalf("expected ttl 0 to be sent with use_ttl, found %v/%v", record["ttl"], record["use_ttl"])
+	}
+
+	d = testUnitPlanUpdate(t, r, d, map[string]interface{}{
+		"hostname":   "unit-aaaa.example.com",
+		"ip_address": "2001:db8::20",
+		"ttl":        600,
+	}, client)
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	record, _ = fake.lookup(d.Id())
+	if record["use_ttl"] != true || fmt.Sprint(record["ttl"]) != "600" {
+		t.Fatalf("expected ttl 600 to be sent with use_ttl, found %v/%v", record["ttl"], record["use_ttl"])
+	}
+
+	d = testUnitPlanUpdate(t, r, d, map[string]interface{}{
+		"hostname":   "unit-aaaa.example.com",
+		"ip_address": "2001:db8::20",
+	}, client)
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	record, _ = fake.lookup(d.Id())
+	if record["use_ttl"] != false {
+		t.Fatalf("expected removing ttl to clear use_ttl, found %v", record["use_ttl"])
+	}
+	if _, ok := d.GetOk("ttl"); ok {
+		t.Fatal("expected ttl to be unset once the zone ttl is used")
+	}
+
+	data := dataSourceAAAARecord()
+	for _, search := range []map[string]interface{}{
+		{"hostname": "unit-aaaa.example.com"},
+		{"dns_name": "unit-aaaa.example.com"},
+		{"ip_address": "2001:db8::20"},
+	} {
+		dd := schema.TestResourceDataRaw(t, data.Schema, search)
+		if diags := data.ReadContext(context.Background(), dd, client); diags.HasError() {
+			t.Fatalf("read %v: %+v", search, diags)
+		}
+		if dd.Id() != d.Id() {
+			t.Fatalf("read %v: expected %s, found %s", search, d.Id(), dd.Id())
+		}
+	}
+}
+
+func TestUnitResourceAAAARecordCanonicalAddress(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	r := resourceAAAARecord()
+	config := map[string]interface{}{
+		"hostname":   "unit-aaaa-canonical.example.com",
+		"ip_address": "2001:DB8:0:0::0021",
+	}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, config)
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	if address := d.Get("ip_address").(string); address != "2001:db8::21" {
+		t.Fatalf("expected the canonical address to be read back, found %s", address)
+	}
+
+	// The grid rewrites the address, which must not show as a change
+	diff, err := r.SimpleDiff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatalf("plan: %s", err)
+	}
+	if diff != nil && diff.Attributes["ip_address"] != nil {
+		t.Fatalf("expected no change for a non-canonical address, found %+v", diff.Attributes["ip_address"])
+	}
+}
+
+func testAccCheckInfobloxAAAARecordExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource: %s not set", resourceName)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckInfobloxAAAARecordCreate(hostname string) string {
+	return fmt.Sprintf(`
+  resource "infoblox_aaaa_record" "new" {
+    ip_address = "2001:db8::10"
+    comment    = "test aaaa record"
+    hostname   = "%s"
+    ttl        = 300
+    extensible_attributes = {
+      Location = jsonencode({
+        value = "CollegeStation",
+        type  = "STRING"
+      })
+    }
+  }
+  data "infoblox_aaaa_record" "hostname" {
+    hostname = infoblox_aaaa_record.new.hostname
+  }
+  data "infoblox_aaaa_record" "ip_address" {
+    ip_address = infoblox_aaaa_record.new.ip_address
+  }
+`, hostname)
+}
+
+func testAccCheckInfobloxAAAARecordUpdate(hostname string) string {
+	return fmt.Sprintf(`
+  resource "infoblox_aaaa_record" "new" {
+    ip_address = "2001:db8::11"
+    comment    = "test aaaa record update"
+    hostname   = "%s"
+    extensible_attributes = {
+      Location = jsonencode({
+        value = "CollegeStation2",
+        type  = "STRING"
+      })
+    }
+  }
+`, hostname)
+}
@@ -2,6 +2,7 @@ package infoblox
 
 import (
 	"context"
+	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -47,6 +48,7 @@ func resourceAliasRecord() *schema.Resource {
 				Description: "Target name in punycode format.",
 				Computed:    true,
 			},
+			"extensible_attribute": extensibleAttributeBlockSchema(),
 			"extensible_attributes": {
 				Type:             schema.TypeMap,
 				Description:      "Extensible attributes of alias record (Values are JSON encoded).",
@@ -164,6 +166,11 @@ func resourceAliasRecordRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	record, err := client.GetAliasRecordByRef(ref, nil)
 	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] alias record %s not found, removing from state", ref)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.FromErr(err)...)
 		return diags
 	}
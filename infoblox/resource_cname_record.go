@@ -2,6 +2,7 @@ package infoblox
 
 import (
 	"context"
+	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -64,6 +65,7 @@ func resourceCNameRecord() *schema.Resource {
 				Description: "The name for the CNAME record in punycode format.",
 				Computed:    true,
 			},
+			"extensible_attribute": extensibleAttributeBlockSchema(),
 			"extensible_attributes": {
 				Type:             schema.TypeMap,
 				Description:      "Extensible attributes of cname record (Values are JSON encoded).",
@@ -158,6 +160,11 @@ func resourceCNameRecordRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	record, err := client.GetCNameRecordByRef(ref, nil)
 	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] CNAME record %s not found, removing from state", ref)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.FromErr(err)...)
 		return diags
 	}
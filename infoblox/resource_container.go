@@ -2,6 +2,7 @@ package infoblox
 
 import (
 	"context"
+	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -26,7 +27,10 @@ func resourceContainer() *schema.Resource {
 			"cidr": {
 				Type:             schema.TypeString,
 				Description:      "The container network address in IPv4 Address/CIDR format.",
-				Required:         true,
+				Optional:         true,
+				Computed:         true,
+				AtLeastOneOf:     []string{"cidr", "parent_cidr", "ea_search"},
+				ConflictsWith:    []string{"ea_search", "parent_cidr"},
 				ForceNew:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IsCIDR),
 			},
@@ -37,6 +41,19 @@ func resourceContainer() *schema.Resource {
 				Computed:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 256)),
 			},
+			"ea_search": {
+				Type:          schema.TypeMap,
+				Description:   "Ea search criteria for next_available_network function",
+				Optional:      true,
+				ConflictsWith: []string{"cidr", "parent_cidr"},
+				AtLeastOneOf:  []string{"cidr", "parent_cidr", "ea_search"},
+				RequiredWith:  []string{"prefix_length"},
+				ForceNew:      true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"extensible_attribute": extensibleAttributeBlockSchema(),
 			"extensible_attributes": {
 				Type:             schema.TypeMap,
 				Description:      "Extensible attributes of A container (Values are JSON encoded).",
@@ -55,6 +72,22 @@ func resourceContainer() *schema.Resource {
 				Optional:    true,
 				ForceNew:    true,
 			},
+			"parent_cidr": {
+				Type:          schema.TypeString,
+				Description:   "Parent container CIDR subnet",
+				Optional:      true,
+				AtLeastOneOf:  []string{"cidr", "parent_cidr", "ea_search"},
+				ConflictsWith: []string{"cidr", "ea_search"},
+				RequiredWith:  []string{"prefix_length"},
+				ForceNew:      true,
+			},
+			"prefix_length": {
+				Type:             schema.TypeInt,
+				Description:      "Desired prefix size of requested container",
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 32)),
+				ForceNew:         true,
+			},
 			"ref": {
 				Type:        schema.TypeString,
 				Description: "Reference id of A container object.",
@@ -110,6 +143,66 @@ func convertResourceDataToContainer(client *infoblox.Client, d *schema.ResourceD
 	return &container, nil
 }
 
+func convertResourceDataToContainerFromContainer(client *infoblox.Client, d *schema.ResourceData) (*infoblox.NetworkContainerFromContainer, error) {
+	var container infoblox.NetworkContainerFromContainer
+
+	prefix := d.Get("prefix_length").(int)
+	parent_cidr := d.Get("parent_cidr").(string)
+
+	if parent_cidr != "" {
+		container.Network = infoblox.NetworkContainerFunction{
+			Function:    "next_available_network",
+			ResultField: "networks",
+			Object:      "networkcontainer",
+			ObjectParameters: map[string]string{
+				"network":      parent_cidr,
+				"network_view": d.Get("network_view").(string),
+			},
+			Parameters: map[string]int{
+				"cidr": prefix,
+			},
+		}
+	} else {
+		ea_search_map := d.Get("ea_search").(map[string]interface{})
+		ea_search := make(map[string]string)
+		for k, v := range ea_search_map {
+			ea_search[k] = v.(string)
+		}
+		ea_search["network_view"] = d.Get("network_view").(string)
+		container.Network = infoblox.NetworkContainerFunction{
+			Function:         "next_available_network",
+			ResultField:      "networks",
+			Object:           "networkcontainer",
+			ObjectParameters: ea_search,
+			Parameters: map[string]int{
+				"cidr": prefix,
+			},
+		}
+	}
+	container.Comment = d.Get("comment").(string)
+	container.NetworkView = d.Get("network_view").(string)
+
+	eaMap := d.Get("extensible_attributes").(map[string]interface{})
+	if len(eaMap) > 0 {
+		eas, err := createExtensibleAttributesFromJSON(eaMap)
+		if err != nil {
+			return &container, err
+		}
+		container.ExtensibleAttributes = &eas
+	}
+
+	if client.OrchestratorEAs != nil && len(*client.OrchestratorEAs) > 0 {
+		if container.ExtensibleAttributes == nil {
+			container.ExtensibleAttributes = &infoblox.ExtensibleAttribute{}
+		}
+		for k, v := range *client.OrchestratorEAs {
+			(*container.ExtensibleAttributes)[k] = v
+		}
+	}
+
+	return &container, nil
+}
+
 func resourceContainerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*infoblox.Client)
 
@@ -118,6 +211,11 @@ func resourceContainerRead(ctx context.Context, d *schema.ResourceData, m interf
 
 	container, err := client.GetContainerByRef(ref, nil)
 	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] container %s not found, removing from state", ref)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.FromErr(err)...)
 		return diags
 	}
@@ -137,16 +235,33 @@ func resourceContainerCreate(ctx context.Context, d *schema.ResourceData, m inte
 
 	var diags diag.Diagnostics
 
-	container, err := convertResourceDataToContainer(client, d)
-	if err != nil {
-		diags = append(diags, diag.FromErr(err)...)
-		return diags
-	}
+	var container *infoblox.NetworkContainer
 
-	err = client.CreateContainer(container)
-	if err != nil {
-		diags = append(diags, diag.FromErr(err)...)
-		return diags
+	cidr := d.Get("cidr").(string)
+	if cidr == "" {
+		c, err := convertResourceDataToContainerFromContainer(client, d)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+		cResult, err := client.CreateContainerFromContainer(c)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+		container = &cResult
+	} else {
+		c, err := convertResourceDataToContainer(client, d)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+		err = client.CreateContainer(c)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+		container = c
 	}
 
 	if diags.HasError() {
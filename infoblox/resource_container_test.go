@@ -1,11 +1,13 @@
 package infoblox
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -112,3 +114,97 @@ resource "infoblox_container" "new"{
 }
 `, containerIPAddress)
 }
+
+func TestUnitInfobloxContainerBasic(t *testing.T) {
+	fake := newFakeWAPI(t)
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testUnitPreCheck(t) },
+		ProviderFactories: testUnitProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: composeConfig(fake.providerConfig(testUnitOrchestratorEAs), `
+  resource "infoblox_container" "new"{
+    cidr         = "10.70.0.0/16"
+    comment      = "test container"
+    network_view = "default"
+  }
+  data "infoblox_container" "ref" {
+    ref = infoblox_container.new.ref
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInfobloxContainerExists("infoblox_container.new"),
+					resource.TestCheckResourceAttr("infoblox_container.new", "cidr", "10.70.0.0/16"),
+					resource.TestCheckResourceAttr("data.infoblox_container.ref", "comment", "test container"),
+				),
+			},
+			{
+				ResourceName:      "infoblox_container.new",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitResourceContainerAllocation(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	fake.create("networkcontainer", map[string]interface{}{
+		"network": "10.80.0.0/12",
+	})
+	r := resourceContainer()
+
+	// region -> site -> vpc without hard-coded intermediate CIDRs
+	site := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"parent_cidr":   "10.80.0.0/12",
+		"prefix_length": 16,
+		"extensible_attributes": map[string]interface{}{
+			"Site": `{"value":"unit-site","type":"STRING"}`,
+		},
+	})
+	if diags := r.CreateContext(context.Background(), site, client); diags.HasError() {
+		t.Fatalf("create site: %+v", diags)
+	}
+	if cidr := site.Get("cidr").(string); cidr != "10.80.0.0/16" {
+		t.Fatalf("expected the first free /16 to be allocated, found %s", cidr)
+	}
+
+	vpc := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"ea_search": map[string]interface{}{
+			"*Site": "unit-site",
+		},
+		"prefix_length": 20,
+		"comment":       "unit vpc",
+	})
+	if diags := r.CreateContext(context.Background(), vpc, client); diags.HasError() {
+		t.Fatalf("create vpc: %+v", diags)
+	}
+	if cidr := vpc.Get("cidr").(string); cidr != "10.80.0.0/20" {
+		t.Fatalf("expected the vpc to be allocated from the site, found %s", cidr)
+	}
+	if comment := vpc.Get("comment").(string); comment != "unit vpc" {
+		t.Fatalf("expected comment to be set on the allocated container, found %s", comment)
+	}
+
+	second := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"parent_cidr":   "10.80.0.0/12",
+		"prefix_length": 16,
+	})
+	if diags := r.CreateContext(context.Background(), second, client); diags.HasError() {
+		t.Fatalf("create second site: %+v", diags)
+	}
+	if cidr := second.Get("cidr").(string); cidr != "10.81.0.0/16" {
+		t.Fatalf("expected the next free /16 to be allocated, found %s", cidr)
+	}
+
+	// The parent must be in the same network view
+	other := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"parent_cidr":   "10.80.0.0/12",
+		"prefix_length": 16,
+		"network_view":  "tenant-a",
+	})
+	if diags := r.CreateContext(context.Background(), other, client); !diags.HasError() {
+		t.Fatal("expected allocation from a container in another network view to fail")
+	}
+}
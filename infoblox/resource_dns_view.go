@@ -0,0 +1,345 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+func resourceDNSView() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDNSViewCreate,
+		ReadContext:   resourceDNSViewRead,
+		UpdateContext: resourceDNSViewUpdate,
+		DeleteContext: resourceDNSViewDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDNSViewImport,
+		},
+		CustomizeDiff: customdiff.Sequence(
+			makeEACustomDiff("extensible_attributes"),
+		),
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:             schema.TypeString,
+				Description:      "Comment for the DNS view; maximum 256 characters.",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 256)),
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Description: "Determines whether the DNS view is disabled.",
+				Optional:    true,
+				Default:     false,
+			},
+			"extensible_attribute": extensibleAttributeBlockSchema(),
+			"extensible_attributes": {
+				Type:             schema.TypeMap,
+				Description:      "Extensible attributes of DNS view (Values are JSON encoded).",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateEa,
+				DiffSuppressFunc: eaSuppressDiff,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"forward_only": {
+				Type:         schema.TypeBool,
+				Description:  "Only send queries to the forwarders instead of falling back to recursion.",
+				Optional:     true,
+				Default:      false,
+				RequiredWith: []string{"forwarders"},
+			},
+			"forwarders": {
+				Type:        schema.TypeList,
+				Description: "IP addresses of the name servers queries in the view are forwarded to. When empty the grid or member forwarders are used.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPAddress),
+				},
+			},
+			"is_default": {
+				Type:        schema.TypeBool,
+				Description: "Whether this is the default DNS view.",
+				Computed:    true,
+			},
+			"match_clients":      addressACSchema("Client addresses the view answers queries for. When empty the view matches any client."),
+			"match_destinations": addressACSchema("Destination addresses the view answers queries on. When empty the view matches any destination."),
+			"name": {
+				Type:             schema.TypeString,
+				Description:      "Name of the DNS view.",
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 64)),
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Description: "The name of the network view associated with the DNS view.",
+				Optional:    true,
+				Default:     "default",
+			},
+			"recursion": {
+				Type:        schema.TypeBool,
+				Description: "Determines whether recursive queries are answered in the view.",
+				Optional:    true,
+				Default:     false,
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Description: "Reference id of DNS view object.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func convertDNSViewToResourceData(client *infoblox.Client, d *schema.ResourceData, view *infoblox.DNSView) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.Set("ref", view.Ref)
+	d.Set("name", view.Name)
+	d.Set("comment", view.Comment)
+	d.Set("disable", view.Disable != nil && *view.Disable)
+	d.Set("network_view", view.NetworkView)
+	d.Set("recursion", view.Recursion != nil && *view.Recursion)
+	d.Set("match_clients", convertAddressACsToList(view.MatchClients))
+	d.Set("match_destinations", convertAddressACsToList(view.MatchDestinations))
+	if view.IsDefault != nil {
+		d.Set("is_default", *view.IsDefault)
+	}
+	if view.UseForwarders != nil && *view.UseForwarders && view.Forwarders != nil {
+		d.Set("forwarders", *view.Forwarders)
+		d.Set("forward_only", view.ForwardOnly != nil && *view.ForwardOnly)
+	} else {
+		d.Set("forwarders", nil)
+		d.Set("forward_only", false)
+	}
+
+	eas, err := client.ConvertEAsToJSONString(*view.ExtensibleAttributes)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	} else {
+		d.Set("extensible_attributes", eas)
+	}
+
+	return diags
+}
+
+func convertResourceDataToDNSView(client *infoblox.Client, d *schema.ResourceData) (*infoblox.DNSView, error) {
+	var view infoblox.DNSView
+
+	view.Name = d.Get("name").(string)
+	view.Comment = d.Get("comment").(string)
+	view.Disable = newBool(d.Get("disable").(bool))
+	view.NetworkView = d.Get("network_view").(string)
+	view.Recursion = newBool(d.Get("recursion").(bool))
+
+	if clients := d.Get("match_clients").([]interface{}); len(clients) > 0 {
+		acs := convertListToAddressACs(clients)
+		view.MatchClients = &acs
+	}
+	if destinations := d.Get("match_destinations").([]interface{}); len(destinations) > 0 {
+		acs := convertListToAddressACs(destinations)
+		view.MatchDestinations = &acs
+	}
+	setDNSViewForwarders(&view, d)
+
+	eaMap := d.Get("extensible_attributes").(map[string]interface{})
+	if len(eaMap) > 0 {
+		eas, err := createExtensibleAttributesFromJSON(eaMap)
+		if err != nil {
+			return &view, err
+		}
+		view.ExtensibleAttributes = &eas
+	}
+
+	if client.OrchestratorEAs != nil && len(*client.OrchestratorEAs) > 0 {
+		if view.ExtensibleAttributes == nil {
+			view.ExtensibleAttributes = &infoblox.ExtensibleAttribute{}
+		}
+		for k, v := range *client.OrchestratorEAs {
+			(*view.ExtensibleAttributes)[k] = v
+		}
+	}
+
+	return &view, nil
+}
+
+// setDNSViewForwarders overrides the grid forwarders whenever forwarders are set
+func setDNSViewForwarders(view *infoblox.DNSView, d *schema.ResourceData) {
+	forwarders := []string{}
+	for _, forwarder := range d.Get("forwarders").([]interface{}) {
+		forwarders = append(forwarders, forwarder.(string))
+	}
+	view.Forwarders = &forwarders
+	view.UseForwarders = newBool(len(forwarders) > 0)
+	view.ForwardOnly = newBool(d.Get("forward_only").(bool))
+}
+
+// resourceDNSViewImport accepts either a reference id or the name of the
+// DNS view
+func resourceDNSViewImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := m.(*infoblox.Client)
+
+	if strings.HasPrefix(d.Id(), "view/") {
+		return []*schema.ResourceData{d}, nil
+	}
+	views, err := client.GetDNSViewByQuery(map[string]string{
+		"name": d.Id(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(views) != 1 {
+		return nil, fmt.Errorf("Expected one DNS view named %s but found %d", d.Id(), len(views))
+	}
+	d.SetId(views[0].Ref)
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceDNSViewRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	view, err := client.GetDNSViewByRef(ref, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] DNS view %s not found, removing from state", ref)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	check := convertDNSViewToResourceData(client, d, &view)
+	if check.HasError() {
+		return check
+	}
+
+	d.SetId(view.Ref)
+
+	return diags
+}
+
+func resourceDNSViewCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+
+	view, err := convertResourceDataToDNSView(client, d)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	err = client.CreateDNSView(view)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	d.SetId(view.Ref)
+	return resourceDNSViewRead(ctx, d, m)
+}
+
+func resourceDNSViewUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*infoblox.Client)
+
+	var view infoblox.DNSView
+
+	if d.HasChange("name") {
+		view.Name = d.Get("name").(string)
+	}
+	if d.HasChange("comment") {
+		view.Comment = d.Get("comment").(string)
+	}
+	if d.HasChange("disable") {
+		view.Disable = newBool(d.Get("disable").(bool))
+	}
+	if d.HasChange("network_view") {
+		view.NetworkView = d.Get("network_view").(string)
+	}
+	if d.HasChange("recursion") {
+		view.Recursion = newBool(d.Get("recursion").(bool))
+	}
+	if d.HasChange("match_clients") {
+		acs := convertListToAddressACs(d.Get("match_clients").([]interface{}))
+		view.MatchClients = &acs
+	}
+	if d.HasChange("match_destinations") {
+		acs := convertListToAddressACs(d.Get("match_destinations").([]interface{}))
+		view.MatchDestinations = &acs
+	}
+	if d.HasChanges("forwarders", "forward_only") {
+		setDNSViewForwarders(&view, d)
+	}
+	if d.HasChange("extensible_attributes") {
+		old, new := d.GetChange("extensible_attributes")
+		oldKeys := Keys(old.(map[string]interface{}))
+		oldEAs, err := createExtensibleAttributesFromJSON(old.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		newKeys := Keys(new.(map[string]interface{}))
+		newEAs, err := createExtensibleAttributesFromJSON(new.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		removeEAs := sliceDiff(oldKeys, newKeys, false)
+		if len(removeEAs) > 0 {
+			view.ExtensibleAttributesRemove = &infoblox.ExtensibleAttribute{}
+			for _, v := range removeEAs {
+				(*view.ExtensibleAttributesRemove)[v] = oldEAs[v]
+			}
+		}
+		for k, v := range newEAs {
+			if !Contains(oldKeys, k) || (Contains(oldKeys, k) && v.Value != oldEAs[k].Value) {
+				if view.ExtensibleAttributesAdd == nil {
+					view.ExtensibleAttributesAdd = &infoblox.ExtensibleAttribute{}
+				}
+				(*view.ExtensibleAttributesAdd)[k] = v
+			}
+		}
+		if client.OrchestratorEAs != nil && len(*client.OrchestratorEAs) > 0 {
+			if view.ExtensibleAttributesAdd == nil {
+				view.ExtensibleAttributesAdd = &infoblox.ExtensibleAttribute{}
+			}
+			for k, v := range *client.OrchestratorEAs {
+				(*view.ExtensibleAttributesAdd)[k] = v
+			}
+		}
+	}
+	changedView, err := client.UpdateDNSView(d.Id(), view)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	d.SetId(changedView.Ref)
+	return resourceDNSViewRead(ctx, d, m)
+}
+
+func resourceDNSViewDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	err := client.DeleteDNSView(ref)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,190 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccInfobloxDNSViewBasic(t *testing.T) {
+	testAccSteps(t, testInfobloxDNSViewSteps)
+}
+
+func TestUnitInfobloxDNSViewBasic(t *testing.T) {
+	testUnitSteps(t, testInfobloxDNSViewSteps)
+}
+
+func testInfobloxDNSViewSteps(providerConfig string) []resource.TestStep {
+	return []resource.TestStep{
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxDNSViewCreate()),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxDNSViewExists("infoblox_dns_view.new"),
+				resource.TestCheckResourceAttr("infoblox_dns_view.new", "name", "terraform-internal"),
+				resource.TestCheckResourceAttr("infoblox_dns_view.new", "network_view", "default"),
+				resource.TestCheckResourceAttr("infoblox_dns_view.new", "comment", "test dns view"),
+				resource.TestCheckResourceAttr("infoblox_dns_view.new", "recursion", "true"),
+				resource.TestCheckResourceAttr("infoblox_dns_view.new", "is_default", "false"),
+				resource.TestCheckResourceAttr("infoblox_dns_view.new", "match_clients.#", "1"),
+				resource.TestCheckResourceAttr("infoblox_dns_view.new", "match_clients.0.address", "10.0.0.0/8"),
+				resource.TestCheckResourceAttr("infoblox_dns_view.new", "forwarders.#", "2"),
+				resource.TestCheckResourceAttr("infoblox_dns_view.new", "forward_only", "true"),
+				resource.TestCheckResourceAttr("infoblox_dns_view.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation\",\"type\":\"STRING\"}"),
+				resource.TestCheckResourceAttr("infoblox_dns_view.new", "extensible_attributes.Orchestrator", "{\"value\":\"Terraform\",\"type\":\"ENUM\"}"),
+				resource.TestCheckResourceAttr("infoblox_zone_auth.new", "view", "terraform-internal"),
+				testAccCheckInfobloxDNSViewExists("data.infoblox_dns_view.name"),
+				resource.TestCheckResourceAttr("data.infoblox_dns_view.name", "recursion", "true"),
+				resource.TestCheckResourceAttr("data.infoblox_dns_view.name", "forwarders.0", "10.10.0.10"),
+			),
+		},
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxDNSViewUpdate()),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxDNSViewExists("infoblox_dns_view.new"),
+				resource.TestCheckResourceAttr("infoblox_dns_view.new", "comment", "test dns view update"),
+				resource.TestCheckResourceAttr("infoblox_dns_view.new", "match_clients.#", "1"),
+				resource.TestCheckResourceAttr("infoblox_dns_view.new", "match_clients.0.permission", "DENY"),
+				resource.TestCheckResourceAttr("infoblox_dns_view.new", "forwarders.#", "0"),
+				resource.TestCheckResourceAttr("infoblox_dns_view.new", "forward_only", "false"),
+				resource.TestCheckResourceAttr("infoblox_dns_view.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation2\",\"type\":\"STRING\"}"),
+			),
+		},
+		{
+			ResourceName:      "infoblox_dns_view.new",
+			ImportState:       true,
+			ImportStateId:     "terraform-internal",
+			ImportStateVerify: true,
+		},
+	}
+}
+
+func TestUnitResourceDNSViewForwarders(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	r := resourceDNSView()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":         "unit-internal",
+		"forwarders":   []interface{}{"10.10.0.10"},
+		"forward_only": true,
+		"match_destinations": []interface{}{
+			map[string]interface{}{"address": "10.0.0.53"},
+		},
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	view, ok := fake.lookup(d.Id())
+	if !ok {
+		t.Fatalf("create: view %s not found in fake grid", d.Id())
+	}
+	if view["use_forwarders"] != true || view["forward_only"] != true {
+		t.Fatalf("expected forwarders to override the grid, found %v/%v", view["use_forwarders"], view["forward_only"])
+	}
+	destinations := view["match_destinations"].([]interface{})
+	if structType := destinations[0].(map[string]interface{})["_struct"]; structType != "addressac" {
+		t.Fatalf("expected an addressac struct, found %v", structType)
+	}
+
+	state := d.State()
+	d, err := schema.InternalMap(r.Schema).Data(state, &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"forwarders.#": {Old: "1", New: "0"},
+			"forwarders.0": {Old: "10.10.0.10", New: "", NewRemoved: true},
+			"forward_only": {Old: "true", New: "false"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	view, _ = fake.lookup(d.Id())
+	if view["use_forwarders"] != false {
+		t.Fatalf("expected removing forwarders to clear use_forwarders, found %v", view["use_forwarders"])
+	}
+
+	data := dataSourceDNSView()
+	dd := schema.TestResourceDataRaw(t, data.Schema, map[string]interface{}{
+		"name": "default",
+	})
+	if diags := data.ReadContext(context.Background(), dd, client); diags.HasError() {
+		t.Fatalf("read: %+v", diags)
+	}
+	if !dd.Get("is_default").(bool) {
+		t.Fatal("expected the default DNS view to be found")
+	}
+}
+
+func testAccCheckInfobloxDNSViewExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource: %s not set", resourceName)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckInfobloxDNSViewCreate() string {
+	return `
+  resource "infoblox_dns_view" "new" {
+    name         = "terraform-internal"
+    comment      = "test dns view"
+    recursion    = true
+    forwarders   = ["10.10.0.10", "10.10.0.11"]
+    forward_only = true
+    match_clients {
+      address = "10.0.0.0/8"
+    }
+    extensible_attributes = {
+      Location = jsonencode({
+        value = "CollegeStation",
+        type  = "STRING"
+      })
+    }
+  }
+  resource "infoblox_zone_auth" "new" {
+    fqdn = "terraform-view.example.com"
+    view = infoblox_dns_view.new.name
+  }
+  data "infoblox_dns_view" "name" {
+    name = infoblox_dns_view.new.name
+  }
+`
+}
+
+func testAccCheckInfobloxDNSViewUpdate() string {
+	return `
+  resource "infoblox_dns_view" "new" {
+    name      = "terraform-internal"
+    comment   = "test dns view update"
+    recursion = true
+    match_clients {
+      address    = "10.99.0.0/16"
+      permission = "DENY"
+    }
+    extensible_attributes = {
+      Location = jsonencode({
+        value = "CollegeStation2",
+        type  = "STRING"
+      })
+    }
+  }
+  resource "infoblox_zone_auth" "new" {
+    fqdn = "terraform-view.example.com"
+    view = infoblox_dns_view.new.name
+  }
+`
+}
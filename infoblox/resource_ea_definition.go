@@ -0,0 +1,373 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+func resourceEADefinition() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEADefinitionCreate,
+		ReadContext:   resourceEADefinitionRead,
+		UpdateContext: resourceEADefinitionUpdate,
+		DeleteContext: resourceEADefinitionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customdiff.Sequence(
+			eaDefinitionCustomDiff,
+		),
+		Schema: map[string]*schema.Schema{
+			"allowed_object_types": {
+				Type:        schema.TypeSet,
+				Description: "The object types this extensible attribute is allowed to associate with.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"comment": {
+				Type:             schema.TypeString,
+				Description:      "Comment for the extensible attribute definition; maximum 256 characters.",
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 256)),
+			},
+			"default_value": {
+				Type:        schema.TypeString,
+				Description: "Default value used to pre-populate the attribute value in the GUI.",
+				Optional:    true,
+			},
+			"descendants_action": {
+				Type:        schema.TypeList,
+				Description: "Action applied to descendants when the inheritable flag changes. This is a one-time action and is not read back from infoblox.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"option_delete_ea": {
+							Type:             schema.TypeString,
+							Description:      "Action for descendants when the extensible attribute is deleted.",
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validOptionDeleteEAValues, false)),
+						},
+						"option_with_ea": {
+							Type:             schema.TypeString,
+							Description:      "Action for descendants that already have the extensible attribute.",
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validOptionWithEAValues, false)),
+						},
+						"option_without_ea": {
+							Type:             schema.TypeString,
+							Description:      "Action for descendants without the extensible attribute.",
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validOptionWithoutEAValues, false)),
+						},
+					},
+				},
+			},
+			"flags": {
+				Type:             schema.TypeString,
+				Description:      "Extensible attribute flags: (A)udited, (C)loud API, Cloud (G)master, (I)nheritable, (L)isted, (M)andatory value, MGM (P)rivate, (R)ead Only, (S)ortable, (V)alue required.",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(validEAFlags, "flags may only contain the characters ACGILMPRSV")),
+			},
+			"list_values": {
+				Type:        schema.TypeList,
+				Description: "List of values allowed for an ENUM extensible attribute.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"max": {
+				Type:        schema.TypeInt,
+				Description: "Maximum value of an INTEGER or maximum length of a STRING extensible attribute.",
+				Optional:    true,
+			},
+			"min": {
+				Type:        schema.TypeInt,
+				Description: "Minimum value of an INTEGER or minimum length of a STRING extensible attribute.",
+				Optional:    true,
+			},
+			"name": {
+				Type:             schema.TypeString,
+				Description:      "Name of the extensible attribute.",
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 128)),
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Description: "Namespace of the extensible attribute.",
+				Computed:    true,
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Description: "Reference id of extensible attribute definition object.",
+				Computed:    true,
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Description:      "Type of the extensible attribute.",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validEATypes, false)),
+			},
+		},
+	}
+}
+
+// eaDefinitionPlannedArgs are the arguments that change how extensible
+// attribute values are checked against a definition
+var eaDefinitionPlannedArgs = []string{"flags", "list_values", "max", "min", "name", "type"}
+
+// eaDefinitionCustomDiff validates type specific arguments and records
+// definitions that are about to change
+func eaDefinitionCustomDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if !diff.NewValueKnown("type") {
+		return nil
+	}
+	eaType := diff.Get("type").(string)
+	if len(diff.Get("list_values").([]interface{})) > 0 && eaType != "ENUM" {
+		return fmt.Errorf("list_values can only be set for ENUM extensible attributes")
+	}
+	if eaType == "ENUM" && len(diff.Get("list_values").([]interface{})) == 0 && diff.NewValueKnown("list_values") {
+		return fmt.Errorf("list_values must be set for ENUM extensible attributes")
+	}
+	for _, arg := range []string{"min", "max"} {
+		value, ok := configuredInt(diff, arg)
+		if ok && eaType != "INTEGER" && eaType != "STRING" {
+			return fmt.Errorf("%s can only be set for INTEGER and STRING extensible attributes", arg)
+		}
+		if ok && eaType == "STRING" && value < 0 {
+			return fmt.Errorf("%s must be at least 0 for STRING extensible attributes", arg)
+		}
+	}
+	min, minOk := configuredInt(diff, "min")
+	max, maxOk := configuredInt(diff, "max")
+	if minOk && maxOk && min > max {
+		return fmt.Errorf("min must be less than or equal to max")
+	}
+	if diff.NewValueKnown("name") && (diff.Id() == "" || diff.HasChanges(eaDefinitionPlannedArgs...)) {
+		v.(*infoblox.Client).PlanEADefinition(plannedEADefinition(diff))
+	}
+
+	return nil
+}
+
+// plannedEADefinition builds the definition an infoblox_ea_definition plans to
+// create or update to, so resources planned alongside it in the same run check
+// their extensible attribute values against it instead of the grid
+func plannedEADefinition(diff *schema.ResourceDiff) infoblox.EADefinition {
+	definition := infoblox.EADefinition{
+		Name:  diff.Get("name").(string),
+		Type:  diff.Get("type").(string),
+		Flags: diff.Get("flags").(string),
+	}
+	for _, value := range diff.Get("list_values").([]interface{}) {
+		definition.ListValues = append(definition.ListValues, infoblox.ListValue{Value: value.(string)})
+	}
+	if min, ok := configuredInt(diff, "min"); ok {
+		definition.Min = newInt(min)
+	}
+	if max, ok := configuredInt(diff, "max"); ok {
+		definition.Max = newInt(max)
+	}
+	return definition
+}
+
+func convertEADefinitionToResourceData(client *infoblox.Client, d *schema.ResourceData, definition *infoblox.EADefinition) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.Set("ref", definition.Ref)
+	d.Set("name", definition.Name)
+	d.Set("type", definition.Type)
+	d.Set("comment", definition.Comment)
+	d.Set("default_value", definition.DefaultValue)
+	d.Set("flags", definition.Flags)
+	d.Set("namespace", definition.Namespace)
+	d.Set("allowed_object_types", definition.AllowedObjectTypes)
+
+	var listValues []string
+	for _, value := range definition.ListValues {
+		listValues = append(listValues, value.Value)
+	}
+	d.Set("list_values", listValues)
+
+	if definition.Min != nil {
+		d.Set("min", *definition.Min)
+	} else {
+		d.Set("min", nil)
+	}
+	if definition.Max != nil {
+		d.Set("max", *definition.Max)
+	} else {
+		d.Set("max", nil)
+	}
+
+	return diags
+}
+
+func convertResourceDataToEADefinition(client *infoblox.Client, d *schema.ResourceData) (*infoblox.EADefinition, error) {
+	var definition infoblox.EADefinition
+
+	definition.Name = d.Get("name").(string)
+	definition.Type = d.Get("type").(string)
+	definition.Comment = d.Get("comment").(string)
+	definition.DefaultValue = d.Get("default_value").(string)
+	definition.Flags = d.Get("flags").(string)
+
+	for _, value := range d.Get("list_values").([]interface{}) {
+		definition.ListValues = append(definition.ListValues, infoblox.ListValue{Value: value.(string)})
+	}
+	for _, objectType := range d.Get("allowed_object_types").(*schema.Set).List() {
+		definition.AllowedObjectTypes = append(definition.AllowedObjectTypes, objectType.(string))
+	}
+	if min, ok := configuredInt(d, "min"); ok {
+		definition.Min = newInt(min)
+	}
+	if max, ok := configuredInt(d, "max"); ok {
+		definition.Max = newInt(max)
+	}
+	definition.DescendantsAction = convertDescendantsAction(d.Get("descendants_action").([]interface{}))
+
+	return &definition, nil
+}
+
+func convertDescendantsAction(actions []interface{}) *infoblox.DescendantsAction {
+	if len(actions) == 0 || actions[0] == nil {
+		return nil
+	}
+	action := actions[0].(map[string]interface{})
+	return &infoblox.DescendantsAction{
+		OptionDeleteEA:  action["option_delete_ea"].(string),
+		OptionWithEA:    action["option_with_ea"].(string),
+		OptionWithoutEA: action["option_without_ea"].(string),
+	}
+}
+
+func resourceEADefinitionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	definition, err := client.GetEADefinitionByRef(ref, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] extensible attribute definition %s not found, removing from state", ref)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	check := convertEADefinitionToResourceData(client, d, &definition)
+	if check.HasError() {
+		return check
+	}
+
+	d.SetId(definition.Ref)
+
+	return diags
+}
+
+func resourceEADefinitionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+
+	definition, err := convertResourceDataToEADefinition(client, d)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	err = client.CreateEADefinition(definition)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+	client.ForgetPlannedEADefinition(definition.Name)
+
+	d.SetId(definition.Ref)
+	return resourceEADefinitionRead(ctx, d, m)
+}
+
+func resourceEADefinitionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*infoblox.Client)
+
+	// Changed fields are sent with their new value, cleared fields with an
+	// empty or null value so they are unset on the grid
+	fields := make(map[string]interface{})
+
+	for _, key := range []string{"name", "comment", "default_value"} {
+		if d.HasChange(key) {
+			fields[key] = d.Get(key).(string)
+		}
+	}
+	if d.HasChange("flags") {
+		fields["flags"] = d.Get("flags").(string)
+		if action := convertDescendantsAction(d.Get("descendants_action").([]interface{})); action != nil {
+			fields["descendants_action"] = action
+		}
+	}
+	if d.HasChange("list_values") {
+		listValues := []infoblox.ListValue{}
+		for _, value := range d.Get("list_values").([]interface{}) {
+			listValues = append(listValues, infoblox.ListValue{Value: value.(string)})
+		}
+		fields["list_values"] = listValues
+	}
+	if d.HasChange("allowed_object_types") {
+		objectTypes := []string{}
+		for _, objectType := range d.Get("allowed_object_types").(*schema.Set).List() {
+			objectTypes = append(objectTypes, objectType.(string))
+		}
+		fields["allowed_object_types"] = objectTypes
+	}
+	for _, key := range []string{"min", "max"} {
+		if d.HasChange(key) {
+			if value, ok := configuredInt(d, key); ok {
+				fields[key] = value
+			} else {
+				fields[key] = nil
+			}
+		}
+	}
+
+	changedDefinition, err := client.UpdateEADefinition(d.Id(), fields)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+	oldName, newName := d.GetChange("name")
+	client.ForgetPlannedEADefinition(oldName.(string))
+	client.ForgetPlannedEADefinition(newName.(string))
+
+	d.SetId(changedDefinition.Ref)
+	return resourceEADefinitionRead(ctx, d, m)
+}
+
+func resourceEADefinitionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	err := client.DeleteEADefinition(ref)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client.ForgetPlannedEADefinition(d.Get("name").(string))
+
+	return diags
+}
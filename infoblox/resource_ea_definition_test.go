@@ -0,0 +1,345 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccInfobloxEADefinitionBasic(t *testing.T) {
+	testAccSteps(t, testInfobloxEADefinitionSteps)
+}
+
+func TestUnitInfobloxEADefinitionBasic(t *testing.T) {
+	testUnitSteps(t, testInfobloxEADefinitionSteps)
+}
+
+func testInfobloxEADefinitionSteps(providerConfig string) []resource.TestStep {
+	return []resource.TestStep{
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxEADefinitionCreate()),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxEADefinitionExists("infoblox_ea_definition.new"),
+				resource.TestCheckResourceAttr("infoblox_ea_definition.new", "name", "TerraformTier"),
+				resource.TestCheckResourceAttr("infoblox_ea_definition.new", "type", "ENUM"),
+				resource.TestCheckResourceAttr("infoblox_ea_definition.new", "comment", "test ea definition"),
+				resource.TestCheckResourceAttr("infoblox_ea_definition.new", "flags", "I"),
+				resource.TestCheckResourceAttr("infoblox_ea_definition.new", "list_values.#", "2"),
+				resource.TestCheckResourceAttr("infoblox_ea_definition.new", "list_values.0", "gold"),
+				resource.TestCheckResourceAttr("infoblox_ea_definition.new", "list_values.1", "silver"),
+				resource.TestCheckResourceAttr("infoblox_ea_definition.new", "default_value", "silver"),
+				testAccCheckInfobloxEADefinitionExists("data.infoblox_ea_definition.name"),
+				resource.TestCheckResourceAttr("data.infoblox_ea_definition.name", "type", "ENUM"),
+				resource.TestCheckResourceAttr("data.infoblox_ea_definition.name", "list_values.#", "2"),
+				resource.TestCheckResourceAttr("infoblox_container.new", "extensible_attributes.TerraformTier", "{\"value\":\"gold\",\"type\":\"ENUM\"}"),
+			),
+		},
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxEADefinitionUpdate()),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxEADefinitionExists("infoblox_ea_definition.new"),
+				resource.TestCheckResourceAttr("infoblox_ea_definition.new", "comment", "test ea definition update"),
+				resource.TestCheckResourceAttr("infoblox_ea_definition.new", "list_values.#", "3"),
+				resource.TestCheckResourceAttr("infoblox_ea_definition.new", "list_values.2", "bronze"),
+				resource.TestCheckResourceAttr("infoblox_container.new", "extensible_attributes.TerraformTier", "{\"value\":\"bronze\",\"type\":\"ENUM\"}"),
+			),
+		},
+		{
+			ResourceName:            "infoblox_ea_definition.new",
+			ImportState:             true,
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{"descendants_action"},
+		},
+	}
+}
+
+func TestUnitResourceEADefinitionCache(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	definitionResource := resourceEADefinition()
+	definitionConfig := map[string]interface{}{
+		"name":        "Tier",
+		"type":        "ENUM",
+		"list_values": []interface{}{"gold", "silver"},
+	}
+
+	d := schema.TestResourceDataRaw(t, definitionResource.Schema, definitionConfig)
+	if diags := definitionResource.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	if _, ok := fake.lookup(d.Id()); !ok {
+		t.Fatalf("create: definition %s not found in fake grid", d.Id())
+	}
+	def, err := client.GetEADefinition("Tier")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if def.Ref != d.Id() {
+		t.Fatalf("expected cached definition to be refreshed with ref %s, found %s", d.Id(), def.Ref)
+	}
+
+	for _, invalid := range []map[string]interface{}{
+		{"name": "Tier", "type": "STRING", "min": 4, "max": 2},
+		{"name": "Tier", "type": "INTEGER", "min": 0, "max": -1},
+		{"name": "Tier", "type": "STRING", "min": -1},
+	} {
+		state := testUnitRawConfigState(t, definitionResource, nil, invalid)
+		if _, err := definitionResource.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(invalid), client); err == nil {
+			t.Fatalf("expected %v to fail planning", invalid)
+		}
+	}
+}
+
+func TestUnitResourceEADefinitionZeroBounds(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	definitionResource := resourceEADefinition()
+
+	// A bound of 0 is sent rather than dropped, and INTEGER bounds may be
+	// negative
+	d := testUnitPlanCreate(t, definitionResource, map[string]interface{}{
+		"name": "Offset",
+		"type": "INTEGER",
+		"min":  -10,
+		"max":  0,
+	}, client)
+	if diags := definitionResource.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	obj, _ := fake.lookup(d.Id())
+	if obj["min"] != float64(-10) || obj["max"] != float64(0) {
+		t.Fatalf("expected min -10 and max 0 on the grid, found %v and %v", obj["min"], obj["max"])
+	}
+
+	d = testUnitPlanUpdate(t, definitionResource, d, map[string]interface{}{
+		"name": "Offset",
+		"type": "INTEGER",
+		"min":  0,
+		"max":  5,
+	}, client)
+	if diags := definitionResource.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	obj, _ = fake.lookup(d.Id())
+	if obj["min"] != float64(0) || obj["max"] != float64(5) {
+		t.Fatalf("expected min 0 and max 5 on the grid, found %v and %v", obj["min"], obj["max"])
+	}
+	if d.Get("min") != 0 || d.Get("max") != 5 {
+		t.Fatalf("expected min 0 and max 5 in state, found %v and %v", d.Get("min"), d.Get("max"))
+	}
+}
+
+func TestUnitResourceEADefinitionClear(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	definitionResource := resourceEADefinition()
+
+	d := testUnitPlanCreate(t, definitionResource, map[string]interface{}{
+		"name":                 "Team",
+		"type":                 "STRING",
+		"comment":              "Owning team",
+		"default_value":        "network",
+		"allowed_object_types": []interface{}{"Network"},
+		"min":                  2,
+		"max":                  64,
+	}, client)
+	if diags := definitionResource.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+
+	// Removing the arguments from the configuration unsets them on the grid
+	d = testUnitPlanUpdate(t, definitionResource, d, map[string]interface{}{
+		"name": "Team",
+		"type": "STRING",
+	}, client)
+	if diags := definitionResource.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	obj, ok := fake.lookup(d.Id())
+	if !ok {
+		t.Fatalf("update: definition %s not found in fake grid", d.Id())
+	}
+	for _, key := range []string{"comment", "default_value"} {
+		if obj[key] != "" {
+			t.Errorf("expected %s to be cleared, found %v", key, obj[key])
+		}
+	}
+	if objectTypes, _ := obj["allowed_object_types"].([]interface{}); len(objectTypes) != 0 {
+		t.Errorf("expected allowed_object_types to be cleared, found %v", obj["allowed_object_types"])
+	}
+	for _, key := range []string{"min", "max"} {
+		if value, present := obj[key]; !present || value != nil {
+			t.Errorf("expected %s to be sent as null, found %v", key, value)
+		}
+		if _, ok := d.GetOk(key); ok {
+			t.Errorf("expected %s to be unset in state, found %v", key, d.Get(key))
+		}
+	}
+	if d.Get("comment") != "" {
+		t.Errorf("expected comment to be unset in state, found %v", d.Get("comment"))
+	}
+}
+
+func TestUnitResourceEADefinitionPlannedValues(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	definitionResource := resourceEADefinition()
+	containerResource := resourceContainer()
+
+	d := schema.TestResourceDataRaw(t, definitionResource.Schema, map[string]interface{}{
+		"name":        "TerraformTier",
+		"type":        "ENUM",
+		"list_values": []interface{}{"gold", "silver"},
+	})
+	if diags := definitionResource.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+
+	container := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"cidr": "10.210.0.0/16",
+		"extensible_attribute": []interface{}{
+			map[string]interface{}{
+				"name":  "TerraformTier",
+				"value": "bronze",
+			},
+		},
+	})
+	if _, err := containerResource.SimpleDiff(context.Background(), nil, container, client); err == nil {
+		t.Fatal("expected a value missing from the definition to fail planning")
+	}
+
+	// Adding the value to the definition in the same plan allows it
+	update := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":        "TerraformTier",
+		"type":        "ENUM",
+		"list_values": []interface{}{"gold", "silver", "bronze"},
+	})
+	if _, err := definitionResource.SimpleDiff(context.Background(), d.State(), update, client); err != nil {
+		t.Fatalf("plan definition: %s", err)
+	}
+	diff, err := containerResource.SimpleDiff(context.Background(), nil, container, client)
+	if err != nil {
+		t.Fatalf("plan container: %s", err)
+	}
+	if attr := diff.Attributes["extensible_attributes.TerraformTier"]; attr == nil || attr.New != `{"value":"bronze","type":"ENUM"}` {
+		t.Errorf("expected planned extensible attribute for the new value, found %+v", attr)
+	}
+
+	// A definition that does not exist yet is planned the same way
+	create := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "TerraformOwner",
+		"type": "STRING",
+	})
+	if _, err := definitionResource.SimpleDiff(context.Background(), nil, create, client); err != nil {
+		t.Fatalf("plan new definition: %s", err)
+	}
+	owned := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"cidr": "10.211.0.0/16",
+		"extensible_attribute": []interface{}{
+			map[string]interface{}{
+				"name":  "TerraformOwner",
+				"value": "network",
+			},
+		},
+	})
+	if _, err := containerResource.SimpleDiff(context.Background(), nil, owned, client); err != nil {
+		t.Fatalf("plan container with new definition: %s", err)
+	}
+
+	// Applying a planned definition hands the checks back to the grid
+	d = testUnitPlanUpdate(t, definitionResource, d, map[string]interface{}{
+		"name":        "TerraformTier",
+		"type":        "ENUM",
+		"list_values": []interface{}{"gold", "silver", "bronze"},
+	}, client)
+	if diags := definitionResource.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	if _, planned := client.PlannedEADefinition("TerraformTier"); planned {
+		t.Fatal("expected the planned definition to be cleared once applied")
+	}
+	if _, err := containerResource.SimpleDiff(context.Background(), nil, container, client); err != nil {
+		t.Fatalf("plan container after update: %s", err)
+	}
+
+	// A destroyed definition no longer validates values
+	if _, err := definitionResource.SimpleDiff(context.Background(), d.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":        "TerraformTier",
+		"type":        "ENUM",
+		"list_values": []interface{}{"gold", "silver", "bronze", "copper"},
+	}), client); err != nil {
+		t.Fatalf("plan definition: %s", err)
+	}
+	if diags := definitionResource.DeleteContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("delete: %+v", diags)
+	}
+	if _, planned := client.PlannedEADefinition("TerraformTier"); planned {
+		t.Fatal("expected the planned definition to be cleared once destroyed")
+	}
+	if _, err := containerResource.SimpleDiff(context.Background(), nil, container, client); err == nil {
+		t.Fatal("expected a value of a destroyed definition to fail planning")
+	}
+}
+
+func testAccCheckInfobloxEADefinitionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource: %s not set", resourceName)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckInfobloxEADefinitionCreate() string {
+	return `
+  resource "infoblox_ea_definition" "new" {
+    name          = "TerraformTier"
+    type          = "ENUM"
+    comment       = "test ea definition"
+    flags         = "I"
+    list_values   = ["gold", "silver"]
+    default_value = "silver"
+  }
+  resource "infoblox_container" "new" {
+    cidr = "10.210.0.0/16"
+    extensible_attribute {
+      name  = infoblox_ea_definition.new.name
+      value = "gold"
+    }
+  }
+  data "infoblox_ea_definition" "name" {
+    name = infoblox_ea_definition.new.name
+  }
+`
+}
+
+func testAccCheckInfobloxEADefinitionUpdate() string {
+	return `
+  resource "infoblox_ea_definition" "new" {
+    name          = "TerraformTier"
+    type          = "ENUM"
+    comment       = "test ea definition update"
+    flags         = "I"
+    list_values   = ["gold", "silver", "bronze"]
+    default_value = "silver"
+  }
+  resource "infoblox_container" "new" {
+    cidr = "10.210.0.0/16"
+    extensible_attribute {
+      name  = infoblox_ea_definition.new.name
+      value = "bronze"
+    }
+  }
+`
+}
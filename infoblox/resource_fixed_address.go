@@ -3,6 +3,7 @@ package infoblox
 import (
 	"context"
 	"fmt"
+	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -54,6 +55,7 @@ func resourceFixedAddress() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"extensible_attribute": extensibleAttributeBlockSchema(),
 			"extensible_attributes": {
 				Type:             schema.TypeMap,
 				Description:      "Extensible attributes of fixed address (Values are JSON encoded).",
@@ -304,6 +306,11 @@ func resourceFixedAddressRead(ctx context.Context, d *schema.ResourceData, m int
 
 	fixedAddress, err := client.GetFixedAddressByRef(ref, nil)
 	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] fixed address %s not found, removing from state", ref)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.FromErr(err)...)
 		return diags
 	}
@@ -300,3 +300,34 @@ resource "infoblox_fixed_address" "range" {
 }
 `
 }
+
+func TestUnitInfobloxFixedAddressFromNetwork(t *testing.T) {
+	fake := newFakeWAPI(t)
+	fake.create("network", map[string]interface{}{
+		"network": "10.60.0.0/24",
+	})
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testUnitPreCheck(t) },
+		ProviderFactories: testUnitProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: composeConfig(fake.providerConfig(testUnitOrchestratorEAs), `
+resource "infoblox_fixed_address" "network" {
+  hostname     = "fixedAddress-test"
+  cidr         = "10.60.0.0/24"
+  comment      = "test fixed address"
+  match_client = "RESERVED"
+  member {
+    hostname = "infoblox.localdomain"
+  }
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInfobloxFixedAddressExists("infoblox_fixed_address.network"),
+					resource.TestCheckResourceAttr("infoblox_fixed_address.network", "ip_address", "10.60.0.1"),
+					resource.TestCheckResourceAttr("infoblox_fixed_address.network", "match_client", "RESERVED"),
+				),
+			},
+		},
+	})
+}
@@ -3,6 +3,7 @@ package infoblox
 import (
 	"context"
 	"fmt"
+	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,10 +14,11 @@ import (
 )
 
 var (
-	hostRecordRequiredIPFields = []string{
-		"network",
-		"ip_address",
-		"range_function_string",
+	// hostRecordAddressFields lists, for each address list, the fields of
+	// which each address sets exactly one
+	hostRecordAddressFields = map[string][]string{
+		"ip_v4_address": {"ip_address", "network", "range_function_string"},
+		"ip_v6_address": {"ip_address", "network"},
 	}
 )
 
@@ -34,6 +36,14 @@ func resourceHostRecord() *schema.Resource {
 			hostRecordAddressDiff,
 		),
 		Schema: map[string]*schema.Schema{
+			"aliases": {
+				Type:        schema.TypeSet,
+				Description: "DNS aliases (CNAMEs) of the host in FQDN format.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"comment": {
 				Type:             schema.TypeString,
 				Description:      "Comment for the record; maximum 256 characters.",
@@ -47,6 +57,7 @@ func resourceHostRecord() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"extensible_attribute": extensibleAttributeBlockSchema(),
 			"extensible_attributes": {
 				Type:             schema.TypeMap,
 				Description:      "Extensible attributes of host record (Values are JSON encoded).",
@@ -64,11 +75,12 @@ func resourceHostRecord() *schema.Resource {
 				Required:    true,
 			},
 			"ip_v4_address": {
-				Type:        schema.TypeList,
-				Description: "IPv4 addresses associated with host record.",
-				Optional:    true,
-				Computed:    true,
-				MinItems:    1,
+				Type:         schema.TypeList,
+				Description:  "IPv4 addresses associated with host record.",
+				Optional:     true,
+				Computed:     true,
+				MinItems:     1,
+				AtLeastOneOf: []string{"ip_v4_address", "ip_v6_address"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"configure_for_dhcp": {
@@ -124,6 +136,62 @@ func resourceHostRecord() *schema.Resource {
 					},
 				},
 			},
+			"ip_v6_address": {
+				Type:         schema.TypeList,
+				Description:  "IPv6 addresses associated with host record.",
+				Optional:     true,
+				Computed:     true,
+				MinItems:     1,
+				AtLeastOneOf: []string{"ip_v4_address", "ip_v6_address"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"configure_for_dhcp": {
+							Type:        schema.TypeBool,
+							Description: "Set this to True to enable the DHCPv6 configuration for this host address.",
+							Optional:    true,
+							Computed:    true,
+						},
+						"duid": {
+							Type:             schema.TypeString,
+							Description:      "DHCPv6 Unique Identifier (DUID) associated with IP address.",
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(duidRegex, "must be colon separated hex bytes")),
+							StateFunc: func(val interface{}) string {
+								return strings.ToLower(val.(string))
+							},
+						},
+						"hostname": {
+							Type:        schema.TypeString,
+							Description: "Hostname associated with IP address.",
+							Computed:    true,
+						},
+						"ip_address": {
+							Type:             schema.TypeString,
+							Description:      "IPv6 address.",
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPv6Address),
+							DiffSuppressFunc: ipv6AddressSuppressDiff,
+						},
+						"network": {
+							Type:             schema.TypeString,
+							Description:      "IPv6 network for host record in CIDR notation (next_available_ip will be retrieved from this network).",
+							Optional:         true,
+							ForceNew:         true,
+							Computed:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validateIPv6CIDR),
+							DiffSuppressFunc: ipv6CIDRSuppressDiff,
+						},
+						"ref": {
+							Type:        schema.TypeString,
+							Description: "Reference id of address object.",
+							Computed:    true,
+						},
+					},
+				},
+			},
 			"network_view": {
 				Type:        schema.TypeString,
 				Description: "The name of the network view in which the host record resides.",
@@ -136,6 +204,12 @@ func resourceHostRecord() *schema.Resource {
 				Description: "Reference id of host record object.",
 				Computed:    true,
 			},
+			"ttl": {
+				Type:             schema.TypeInt,
+				Description:      "The Time To Live (TTL) value for the record. When unset the zone TTL is used.",
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
 			"view": {
 				Type:        schema.TypeString,
 				Description: "The name of the DNS view in which the record resides.",
@@ -162,6 +236,16 @@ func convertHostRecordToResourceData(client *infoblox.Client, d *schema.Resource
 	d.Set("network_view", record.NetworkView)
 	d.Set("view", record.View)
 	d.Set("zone", record.Zone)
+	if record.Aliases != nil {
+		d.Set("aliases", *record.Aliases)
+	} else {
+		d.Set("aliases", nil)
+	}
+	if record.UseTTL != nil && *record.UseTTL && record.TTL != nil {
+		d.Set("ttl", *record.TTL)
+	} else {
+		d.Set("ttl", nil)
+	}
 
 	configuredAddressList := d.Get("ip_v4_address").([]interface{})
 	var ipAddressList []map[string]interface{}
@@ -186,6 +270,30 @@ func convertHostRecordToResourceData(client *infoblox.Client, d *schema.Resource
 
 	d.Set("ip_v4_address", ipAddressList)
 
+	configuredIPv6AddressList := d.Get("ip_v6_address").([]interface{})
+	var ipv6AddressList []map[string]interface{}
+	var ipv6Addrs []infoblox.IPv6Addr
+	if record.IPv6Addrs != nil {
+		ipv6Addrs = *record.IPv6Addrs
+	}
+	for i, address := range ipv6Addrs {
+		newAddr := map[string]interface{}{
+			"ref":                address.Ref,
+			"ip_address":         address.IPAddress,
+			"hostname":           address.Host,
+			"duid":               address.Duid,
+			"configure_for_dhcp": address.ConfigureForDHCP,
+			"network":            address.CIDR,
+		}
+		if i < len(configuredIPv6AddressList) {
+			newAddr["network"] = configuredIPv6AddressList[i].(map[string]interface{})["network"].(string)
+		}
+
+		ipv6AddressList = append(ipv6AddressList, newAddr)
+	}
+
+	d.Set("ip_v6_address", ipv6AddressList)
+
 	eas, err := client.ConvertEAsToJSONString(*record.ExtensibleAttributes)
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)...)
@@ -205,6 +313,13 @@ func convertResourceDataToHostRecord(client *infoblox.Client, d *schema.Resource
 	record.NetworkView = d.Get("network_view").(string)
 	record.View = d.Get("view").(string)
 	record.Zone = d.Get("zone").(string)
+	if aliases := d.Get("aliases").(*schema.Set).List(); len(aliases) > 0 {
+		record.Aliases = newStringSlice(aliases)
+	}
+	if ttl, ok := configuredInt(d, "ttl"); ok {
+		record.TTL = newInt(ttl)
+		record.UseTTL = newBool(true)
+	}
 
 	ipAddressList := d.Get("ip_v4_address").([]interface{})
 	record.IPv4Addrs = []infoblox.IPv4Addr{}
@@ -228,6 +343,10 @@ func convertResourceDataToHostRecord(client *infoblox.Client, d *schema.Resource
 		record.IPv4Addrs = append(record.IPv4Addrs, ipv4Addr)
 	}
 
+	if ipv6AddressList := d.Get("ip_v6_address").([]interface{}); len(ipv6AddressList) > 0 {
+		record.IPv6Addrs = convertIPv6AddrList(ipv6AddressList)
+	}
+
 	eaMap := d.Get("extensible_attributes").(map[string]interface{})
 	if len(eaMap) > 0 {
 		eas, err := createExtensibleAttributesFromJSON(eaMap)
@@ -249,6 +368,24 @@ func convertResourceDataToHostRecord(client *infoblox.Client, d *schema.Resource
 	return &record, nil
 }
 
+// convertIPv6AddrList converts the configured IPv6 addresses, returning an
+// empty rather than a nil list so clearing every address can be sent
+func convertIPv6AddrList(ipAddressList []interface{}) *[]infoblox.IPv6Addr {
+	ipv6Addrs := []infoblox.IPv6Addr{}
+	for _, address := range ipAddressList {
+		var ipv6Addr infoblox.IPv6Addr
+		if address.(map[string]interface{})["ip_address"].(string) != "" {
+			ipv6Addr.IPAddress = address.(map[string]interface{})["ip_address"].(string)
+		} else if address.(map[string]interface{})["network"].(string) != "" {
+			ipv6Addr.IPAddress = fmt.Sprintf("func:nextavailableip:%s", address.(map[string]interface{})["network"].(string))
+		}
+		ipv6Addr.Duid = strings.ToLower(address.(map[string]interface{})["duid"].(string))
+		ipv6Addr.ConfigureForDHCP = newBool(address.(map[string]interface{})["configure_for_dhcp"].(bool))
+		ipv6Addrs = append(ipv6Addrs, ipv6Addr)
+	}
+	return &ipv6Addrs
+}
+
 func resourceHostRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*infoblox.Client)
 
@@ -257,6 +394,11 @@ func resourceHostRecordRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	record, err := client.GetHostRecordByRef(ref, nil)
 	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] host record %s not found, removing from state", ref)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.FromErr(err)...)
 		return diags
 	}
@@ -276,29 +418,12 @@ func resourceHostRecordCreate(ctx context.Context, d *schema.ResourceData, m int
 
 	var diags diag.Diagnostics
 
-	// Check that required IP fields are present
-	if ipAddresses, ok := d.GetOk("ip_v4_address"); ok {
-		for _, a := range ipAddresses.([]interface{}) {
-			address := a.(map[string]interface{})
-			matchArgs := []string{}
-			for _, f := range hostRecordRequiredIPFields {
-				if address[f] != "" {
-					matchArgs = append(matchArgs, f)
-				}
-			}
-			if len(matchArgs) == 0 {
-				diags = append(diags, diag.Diagnostic{
-					Severity: diag.Error,
-					Summary:  "Missing ip_v4_address required argument",
-					Detail:   fmt.Sprintf("At least one of %s required for ip_v4_address", strings.Join(hostRecordRequiredIPFields, ", ")),
-				})
-				return diags
-			} else if len(matchArgs) > 1 {
-				diags = append(diags, diag.Diagnostic{
-					Severity: diag.Error,
-					Summary:  "Conflicting arguments found for ip_v4_address",
-					Detail:   fmt.Sprintf("Only one of %s is allowed for ip_v4_address but found %s", strings.Join(hostRecordRequiredIPFields, ", "), strings.Join(matchArgs, ", ")),
-				})
+	// Check that required IP fields are present; the plan only checks them
+	// once the addresses are known
+	for _, key := range []string{"ip_v4_address", "ip_v6_address"} {
+		for _, address := range d.Get(key).([]interface{}) {
+			if err := checkHostRecordAddressFields(key, address.(map[string]interface{}), hostRecordAddressFields[key]); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
 				return diags
 			}
 		}
@@ -352,6 +477,16 @@ func resourceHostRecordUpdate(ctx context.Context, d *schema.ResourceData, m int
 			})
 		}
 	}
+	if d.HasChange("aliases") {
+		record.Aliases = newStringSlice(d.Get("aliases").(*schema.Set).List())
+	}
+	if d.HasChange("ttl") {
+		ttl, ok := configuredInt(d, "ttl")
+		if ok {
+			record.TTL = newInt(ttl)
+		}
+		record.UseTTL = newBool(ok)
+	}
 	if d.HasChange("network_view") {
 		record.NetworkView = d.Get("network_view").(string)
 	}
@@ -381,6 +516,9 @@ func resourceHostRecordUpdate(ctx context.Context, d *schema.ResourceData, m int
 			}
 		}
 	}
+	if d.HasChange("ip_v6_address") {
+		record.IPv6Addrs = convertIPv6AddrList(d.Get("ip_v6_address").([]interface{}))
+	}
 	if d.HasChange("extensible_attributes") {
 		old, new := d.GetChange("extensible_attributes")
 		oldKeys := Keys(old.(map[string]interface{}))
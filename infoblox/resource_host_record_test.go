@@ -1,11 +1,13 @@
 package infoblox
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/techBeck03/go-ipmath"
 )
@@ -291,3 +293,270 @@ func testAccCheckInfobloxHostRecordUpdateFromRange() string {
 	  }
 	`, hostRecordHostnameUpdateRange)
 }
+
+func TestUnitInfobloxHostRecordFromNetwork(t *testing.T) {
+	fake := newFakeWAPI(t)
+	fake.create("network", map[string]interface{}{
+		"network": "10.40.0.0/24",
+	})
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testUnitPreCheck(t) },
+		ProviderFactories: testUnitProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: composeConfig(fake.providerConfig(testUnitOrchestratorEAs), `
+  resource "infoblox_host_record" "network" {
+    hostname   = "unit-host.example.com"
+    comment    = "test host record"
+    enable_dns = true
+    ip_v4_address {
+      network                = "10.40.0.0/24"
+      use_for_ea_inheritance = true
+    }
+    extensible_attributes = {
+      Owner = jsonencode({
+        value = "leroyjenkins",
+        type  = "STRING",
+      })
+    }
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInfobloxHostRecordExists("infoblox_host_record.network"),
+					resource.TestCheckResourceAttr("infoblox_host_record.network", "hostname", "unit-host.example.com"),
+					resource.TestCheckResourceAttr("infoblox_host_record.network", "ip_v4_address.0.ip_address", "10.40.0.1"),
+					resource.TestCheckResourceAttr("infoblox_host_record.network", "extensible_attributes.Owner", "{\"value\":\"leroyjenkins\",\"type\":\"STRING\"}"),
+				),
+			},
+			{
+				ResourceName:            "infoblox_host_record.network",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ip_v4_address.0.network", "ip_v4_address.0.range_function_string"},
+			},
+		},
+	})
+}
+
+func TestUnitResourceHostRecordIPv6(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	fake.create("network", map[string]interface{}{
+		"network": "10.41.0.0/24",
+	})
+	fake.create("ipv6network", map[string]interface{}{
+		"network": "2001:db8:41::/64",
+	})
+
+	r := resourceHostRecord()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"hostname": "unit-dual.example.com",
+		"ip_v6_address": []interface{}{
+			map[string]interface{}{
+				"ip_address": "2001:db8:41::10",
+				"network":    "2001:db8:41::/64",
+			},
+		},
+	})
+	if diags := r.CreateContext(context.Background(), d, client); !diags.HasError() {
+		t.Fatal("expected ip_address and network to conflict for ip_v6_address")
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"hostname": "unit-dual.example.com",
+		"ip_v4_address": []interface{}{
+			map[string]interface{}{"network": "10.41.0.0/24"},
+		},
+		"ip_v6_address": []interface{}{
+			map[string]interface{}{
+				"network":            "2001:db8:41::/64",
+				"duid":               "00:01:00:01:AA:BB:CC:DD:00:00:00:41",
+				"configure_for_dhcp": true,
+			},
+		},
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	if ip := d.Get("ip_v4_address.0.ip_address").(string); ip != "10.41.0.1" {
+		t.Fatalf("expected the next free IPv4 address, found %s", ip)
+	}
+	if ip := d.Get("ip_v6_address.0.ip_address").(string); ip != "2001:db8:41::1" {
+		t.Fatalf("expected the next free IPv6 address, found %s", ip)
+	}
+	if network := d.Get("ip_v6_address.0.network").(string); network != "2001:db8:41::/64" {
+		t.Fatalf("expected the configured network to be kept, found %s", network)
+	}
+	record, _ := fake.lookup(d.Id())
+	address := record["ipv6addrs"].([]interface{})[0].(map[string]interface{})
+	if address["duid"] != "00:01:00:01:aa:bb:cc:dd:00:00:00:41" || address["configure_for_dhcp"] != true {
+		t.Fatalf("expected the duid and configure_for_dhcp to be sent, found %v", address)
+	}
+
+	data := dataSourceHostRecord()
+	dd := schema.TestResourceDataRaw(t, data.Schema, map[string]interface{}{
+		"hostname": "unit-dual.example.com",
+	})
+	if diags := data.ReadContext(context.Background(), dd, client); diags.HasError() {
+		t.Fatalf("read: %+v", diags)
+	}
+	if ip := dd.Get("ip_v6_address.0.ip_address").(string); ip != "2001:db8:41::1" {
+		t.Fatalf("expected data source to return the IPv6 address, found %s", ip)
+	}
+	if network := dd.Get("ip_v6_address.0.network").(string); network != "2001:db8:41::/64" {
+		t.Fatalf("expected data source to return the IPv6 network, found %s", network)
+	}
+}
+
+func TestUnitResourceHostRecordCanonicalIPv6(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	fake.create("ipv6network", map[string]interface{}{
+		"network": "2001:db8:42::/64",
+	})
+	r := resourceHostRecord()
+	config := map[string]interface{}{
+		"hostname": "unit-canonical.example.com",
+		"ip_v6_address": []interface{}{
+			map[string]interface{}{"ip_address": "2001:DB8:42:0::0010"},
+		},
+	}
+	if diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"hostname": "unit-canonical.example.com",
+		"ip_v6_address": []interface{}{
+			map[string]interface{}{"network": "10.42.0.0/24"},
+		},
+	})); !diags.HasError() {
+		t.Fatal("expected an IPv4 network to be rejected for ip_v6_address")
+	}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, config)
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	if ip := d.Get("ip_v6_address.0.ip_address").(string); ip != "2001:db8:42::10" {
+		t.Fatalf("expected the canonical address to be read back, found %s", ip)
+	}
+
+	// The grid rewrites the address, which must not replace the record
+	diff, err := r.SimpleDiff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatalf("plan: %s", err)
+	}
+	if diff != nil && diff.RequiresNew() {
+		t.Fatalf("expected no replacement for a non-canonical address, found %+v", diff.Attributes)
+	}
+}
+
+func TestUnitResourceHostRecordRemoveIPv6(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	r := resourceHostRecord()
+
+	config := map[string]interface{}{
+		"hostname": "unit-remove.example.com",
+		"ip_v4_address": []interface{}{
+			map[string]interface{}{"ip_address": "10.43.0.10"},
+		},
+		"ip_v6_address": []interface{}{
+			map[string]interface{}{"ip_address": "2001:db8:43::10"},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, config)
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+
+	// Keeping the configuration plans no address changes
+	d = testUnitPlanUpdate(t, r, d, config, client)
+	if d.HasChange("ip_v4_address") || d.HasChange("ip_v6_address") {
+		t.Fatal("expected an unchanged configuration to keep the addresses")
+	}
+
+	delete(config, "ip_v6_address")
+	d = testUnitPlanUpdate(t, r, d, config, client)
+	if !d.HasChange("ip_v6_address") {
+		t.Fatal("expected removing ip_v6_address to be planned")
+	}
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	record, _ := fake.lookup(d.Id())
+	if addresses, ok := record["ipv6addrs"].([]interface{}); !ok || len(addresses) != 0 {
+		t.Fatalf("expected the IPv6 addresses to be cleared, found %v", record["ipv6addrs"])
+	}
+	if count := d.Get("ip_v6_address.#").(int); count != 0 {
+		t.Fatalf("expected no IPv6 addresses in state, found %d", count)
+	}
+	if ip := d.Get("ip_v4_address.0.ip_address").(string); ip != "10.43.0.10" {
+		t.Fatalf("expected the IPv4 address to be kept, found %s", ip)
+	}
+}
+
+func TestUnitResourceHostRecordAliases(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	r := resourceHostRecord()
+
+	config := map[string]interface{}{
+		"hostname": "unit-alias.example.com",
+		"aliases":  []interface{}{"www.example.com", "web.example.com"},
+		"ttl":      0,
+		"ip_v4_address": []interface{}{
+			map[string]interface{}{"ip_address": "10.42.0.10"},
+		},
+	}
+
+	// A ttl of 0 is sent rather than treated as unset
+	d := testUnitPlanCreate(t, r, config, client)
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	record, _ := fake.lookup(d.Id())
+	if aliases, _ := record["aliases"].([]interface{}); len(aliases) != 2 {
+		t.Fatalf("expected both aliases to be sent, found %v", record["aliases"])
+	}
+	if record["use_ttl"] != true || fmt.Sprint(record["ttl"]) != "0" {
+		t.Fatalf("expected ttl 0 to be sent with use_ttl, found %v/%v", record["ttl"], record["use_ttl"])
+	}
+
+	config["ttl"] = 600
+	d = testUnitPlanUpdate(t, r, d, config, client)
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	record, _ = fake.lookup(d.Id())
+	if record["use_ttl"] != true || fmt.Sprint(record["ttl"]) != "600" {
+		t.Fatalf("expected ttl 600 to be sent with use_ttl, found %v/%v", record["ttl"], record["use_ttl"])
+	}
+
+	// Dropping an alias and the ttl updates the record in place
+	config["aliases"] = []interface{}{"www.example.com"}
+	delete(config, "ttl")
+	ref := d.Id()
+	d = testUnitPlanUpdate(t, r, d, config, client)
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	if d.Id() != ref {
+		t.Fatalf("expected %s to be updated in place, found %s", ref, d.Id())
+	}
+	record, _ = fake.lookup(d.Id())
+	if fmt.Sprint(record["aliases"]) != "[www.example.com]" || record["use_ttl"] != false {
+		t.Fatalf("expected one alias and the zone ttl, found %v/%v", record["aliases"], record["use_ttl"])
+	}
+
+	data := dataSourceHostRecord()
+	dd := schema.TestResourceDataRaw(t, data.Schema, map[string]interface{}{
+		"hostname": "unit-alias.example.com",
+	})
+	if diags := data.ReadContext(context.Background(), dd, client); diags.HasError() {
+		t.Fatalf("read: %+v", diags)
+	}
+	if aliases := dd.Get("aliases").(*schema.Set); aliases.Len() != 1 || !aliases.Contains("www.example.com") {
+		t.Fatalf("expected data source to return the alias, found %v", aliases.List())
+	}
+	if _, ok := dd.GetOk("ttl"); ok {
+		t.Fatal("expected data source ttl to be unset once the zone ttl is used")
+	}
+}
@@ -0,0 +1,278 @@
+package infoblox
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+func resourceIPv6Container() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIPv6ContainerCreate,
+		ReadContext:   resourceIPv6ContainerRead,
+		UpdateContext: resourceIPv6ContainerUpdate,
+		DeleteContext: resourceIPv6ContainerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customdiff.Sequence(
+			makeEACustomDiff("extensible_attributes"),
+		),
+		Schema: map[string]*schema.Schema{
+			"cidr": {
+				Type:             schema.TypeString,
+				Description:      "The container network address in IPv6 Address/CIDR format.",
+				Optional:         true,
+				Computed:         true,
+				AtLeastOneOf:     []string{"cidr", "parent_cidr", "ea_search"},
+				ConflictsWith:    []string{"ea_search", "parent_cidr"},
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validateIPv6CIDR),
+				DiffSuppressFunc: ipv6CIDRSuppressDiff,
+			},
+			"comment": {
+				Type:             schema.TypeString,
+				Description:      "Comment for the container; maximum 256 characters.",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 256)),
+			},
+			"ea_search": {
+				Type:          schema.TypeMap,
+				Description:   "Ea search criteria for next_available_network function",
+				Optional:      true,
+				ConflictsWith: []string{"cidr", "parent_cidr"},
+				AtLeastOneOf:  []string{"cidr", "parent_cidr", "ea_search"},
+				RequiredWith:  []string{"prefix_length"},
+				ForceNew:      true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"extensible_attribute": extensibleAttributeBlockSchema(),
+			"extensible_attributes": {
+				Type:             schema.TypeMap,
+				Description:      "Extensible attributes of IPv6 container (Values are JSON encoded).",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateEa,
+				DiffSuppressFunc: eaSuppressDiff,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Description: "The name of the network view in which this container resides.",
+				Default:     "default",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"parent_cidr": {
+				Type:             schema.TypeString,
+				Description:      "Parent IPv6 container CIDR subnet",
+				Optional:         true,
+				AtLeastOneOf:     []string{"cidr", "parent_cidr", "ea_search"},
+				ConflictsWith:    []string{"cidr", "ea_search"},
+				RequiredWith:     []string{"prefix_length"},
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validateIPv6CIDR),
+				DiffSuppressFunc: ipv6CIDRSuppressDiff,
+			},
+			"prefix_length": {
+				Type:             schema.TypeInt,
+				Description:      "Desired prefix size of requested container",
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 128)),
+				ForceNew:         true,
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Description: "Reference id of IPv6 container object.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func convertIPv6ContainerToResourceData(client *infoblox.Client, d *schema.ResourceData, container *infoblox.IPv6NetworkContainer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.Set("ref", container.Ref)
+	d.Set("cidr", container.CIDR)
+	d.Set("comment", container.Comment)
+	d.Set("network_view", container.NetworkView)
+
+	eas, err := client.ConvertEAsToJSONString(*container.ExtensibleAttributes)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	} else {
+		d.Set("extensible_attributes", eas)
+	}
+
+	return diags
+}
+
+func convertResourceDataToIPv6Container(client *infoblox.Client, d *schema.ResourceData) (*infoblox.IPv6NetworkContainer, error) {
+	var container infoblox.IPv6NetworkContainer
+
+	container.CIDR = d.Get("cidr").(string)
+	container.Comment = d.Get("comment").(string)
+	container.NetworkView = d.Get("network_view").(string)
+
+	eaMap := d.Get("extensible_attributes").(map[string]interface{})
+	if len(eaMap) > 0 {
+		eas, err := createExtensibleAttributesFromJSON(eaMap)
+		if err != nil {
+			return &container, err
+		}
+		container.ExtensibleAttributes = &eas
+	}
+
+	if client.OrchestratorEAs != nil && len(*client.OrchestratorEAs) > 0 {
+		if container.ExtensibleAttributes == nil {
+			container.ExtensibleAttributes = &infoblox.ExtensibleAttribute{}
+		}
+		for k, v := range *client.OrchestratorEAs {
+			(*container.ExtensibleAttributes)[k] = v
+		}
+	}
+
+	return &container, nil
+}
+
+func resourceIPv6ContainerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	container, err := client.GetIPv6ContainerByRef(ref, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] IPv6 container %s not found, removing from state", ref)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	check := convertIPv6ContainerToResourceData(client, d, &container)
+	if check.HasError() {
+		return check
+	}
+
+	d.SetId(container.Ref)
+
+	return diags
+}
+
+func resourceIPv6ContainerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+
+	container, err := convertResourceDataToIPv6Container(client, d)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	if container.CIDR == "" {
+		result, err := client.CreateIPv6ContainerFromContainer(&infoblox.NetworkContainerFromContainer{
+			NetworkView:          container.NetworkView,
+			Network:              ipv6NextAvailableNetwork(d),
+			Comment:              container.Comment,
+			ExtensibleAttributes: container.ExtensibleAttributes,
+		})
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+		container = &result
+	} else {
+		err = client.CreateIPv6Container(container)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+	}
+
+	d.SetId(container.Ref)
+	return resourceIPv6ContainerRead(ctx, d, m)
+}
+
+func resourceIPv6ContainerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*infoblox.Client)
+
+	var container infoblox.IPv6NetworkContainer
+
+	if d.HasChange("comment") {
+		container.Comment = d.Get("comment").(string)
+	}
+	if d.HasChange("extensible_attributes") {
+		old, new := d.GetChange("extensible_attributes")
+		oldKeys := Keys(old.(map[string]interface{}))
+		oldEAs, err := createExtensibleAttributesFromJSON(old.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		newKeys := Keys(new.(map[string]interface{}))
+		newEAs, err := createExtensibleAttributesFromJSON(new.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		removeEAs := sliceDiff(oldKeys, newKeys, false)
+		if len(removeEAs) > 0 {
+			container.ExtensibleAttributesRemove = &infoblox.ExtensibleAttribute{}
+			for _, v := range removeEAs {
+				(*container.ExtensibleAttributesRemove)[v] = oldEAs[v]
+			}
+		}
+		for k, v := range newEAs {
+			if !Contains(oldKeys, k) || (Contains(oldKeys, k) && v.Value != oldEAs[k].Value) {
+				if container.ExtensibleAttributesAdd == nil {
+					container.ExtensibleAttributesAdd = &infoblox.ExtensibleAttribute{}
+				}
+				(*container.ExtensibleAttributesAdd)[k] = v
+			}
+		}
+		if client.OrchestratorEAs != nil && len(*client.OrchestratorEAs) > 0 {
+			if container.ExtensibleAttributesAdd == nil {
+				container.ExtensibleAttributesAdd = &infoblox.ExtensibleAttribute{}
+			}
+			for k, v := range *client.OrchestratorEAs {
+				(*container.ExtensibleAttributesAdd)[k] = v
+			}
+		}
+	}
+
+	changedContainer, err := client.UpdateIPv6Container(d.Id(), container)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	d.SetId(changedContainer.Ref)
+	return resourceIPv6ContainerRead(ctx, d, m)
+}
+
+func resourceIPv6ContainerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	err := client.DeleteIPv6Container(ref)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
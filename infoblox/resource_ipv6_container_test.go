@@ -0,0 +1,130 @@
+package infoblox
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestUnitResourceIPv6ContainerCRUD(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	r := resourceIPv6Container()
+
+	d := testUnitPlanCreate(t, r, map[string]interface{}{
+		"cidr":    "2001:db8:500::/48",
+		"comment": "unit ipv6 container",
+		"extensible_attributes": map[string]interface{}{
+			"Location": `{"value":"CollegeStation","type":"STRING"}`,
+		},
+	}, client)
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	container, ok := fake.lookup(d.Id())
+	if !ok {
+		t.Fatalf("create: container %s not found in fake grid", d.Id())
+	}
+	if container["network"] != "2001:db8:500::/48" || container["comment"] != "unit ipv6 container" {
+		t.Fatalf("create: unexpected container %v", container)
+	}
+	if d.Get("ref").(string) != d.Id() || d.Get("network_view").(string) != "default" {
+		t.Fatalf("create: expected ref and network_view to be read back, found %v", d.State().Attributes)
+	}
+
+	d = testUnitPlanUpdate(t, r, d, map[string]interface{}{
+		"cidr":    "2001:db8:500::/48",
+		"comment": "unit ipv6 container update",
+		"extensible_attributes": map[string]interface{}{
+			"Site": `{"value":"ipv6-unit-site","type":"STRING"}`,
+		},
+	}, client)
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	container, _ = fake.lookup(d.Id())
+	if container["comment"] != "unit ipv6 container update" {
+		t.Fatalf("update: expected the comment to change, found %v", container["comment"])
+	}
+	eas := container["extattrs"].(map[string]interface{})
+	if _, ok := eas["Location"]; ok || eas["Site"] == nil {
+		t.Fatalf("update: expected Location to be replaced by Site, found %v", eas)
+	}
+	if eas := d.Get("extensible_attributes").(map[string]interface{}); len(eas) != 1 || eas["Site"] == nil {
+		t.Fatalf("update: expected Site to be read back, found %v", eas)
+	}
+
+	if diags := r.DeleteContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("delete: %+v", diags)
+	}
+	if _, ok := fake.lookup(d.Id()); ok {
+		t.Fatalf("delete: container %s still in fake grid", d.Id())
+	}
+	if diags := r.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read: %+v", diags)
+	}
+	if d.Id() != "" {
+		t.Fatalf("read: expected a deleted container to be removed from state, found %s", d.Id())
+	}
+}
+
+func TestUnitResourceIPv6ContainerAllocation(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	fake.create("ipv6networkcontainer", map[string]interface{}{
+		"network": "2001:db8:600::/48",
+	})
+	r := resourceIPv6Container()
+
+	site := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"parent_cidr":   "2001:db8:600::/48",
+		"prefix_length": 56,
+		"comment":       "unit ipv6 site",
+		"extensible_attributes": map[string]interface{}{
+			"Site": `{"value":"ipv6-unit-site","type":"STRING"}`,
+		},
+	})
+	if diags := r.CreateContext(context.Background(), site, client); diags.HasError() {
+		t.Fatalf("create site: %+v", diags)
+	}
+	if cidr := site.Get("cidr").(string); cidr != "2001:db8:600::/56" {
+		t.Fatalf("expected the first free /56 to be allocated, found %s", cidr)
+	}
+	if comment := site.Get("comment").(string); comment != "unit ipv6 site" {
+		t.Fatalf("expected comment to be set on the allocated container, found %s", comment)
+	}
+
+	vpc := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"ea_search": map[string]interface{}{
+			"*Site": "ipv6-unit-site",
+		},
+		"prefix_length": 60,
+	})
+	if diags := r.CreateContext(context.Background(), vpc, client); diags.HasError() {
+		t.Fatalf("create vpc: %+v", diags)
+	}
+	if cidr := vpc.Get("cidr").(string); cidr != "2001:db8:600::/60" {
+		t.Fatalf("expected the vpc to be allocated from the site, found %s", cidr)
+	}
+
+	second := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"parent_cidr":   "2001:db8:600::/48",
+		"prefix_length": 56,
+	})
+	if diags := r.CreateContext(context.Background(), second, client); diags.HasError() {
+		t.Fatalf("create second site: %+v", diags)
+	}
+	if cidr := second.Get("cidr").(string); cidr != "2001:db8:600:100::/56" {
+		t.Fatalf("expected the next free /56 to be allocated, found %s", cidr)
+	}
+
+	// A prefix longer than the container allows is rejected by the grid
+	invalid := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"parent_cidr":   "2001:db8:600::/48",
+		"prefix_length": 32,
+	})
+	if diags := r.CreateContext(context.Background(), invalid, client); !diags.HasError() {
+		t.Fatal("expected allocating a /32 from a /48 to fail")
+	}
+}
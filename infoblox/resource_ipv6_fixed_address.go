@@ -0,0 +1,472 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+var (
+	ipv6FixedAddressRequiredFields = []string{
+		"cidr",
+		"ip_address",
+		"ipv6_prefix",
+	}
+	duidRegex = regexp.MustCompile(`^([0-9a-fA-F]{2}:)*[0-9a-fA-F]{2}$`)
+)
+
+func resourceIPv6FixedAddress() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIPv6FixedAddressCreate,
+		ReadContext:   resourceIPv6FixedAddressRead,
+		UpdateContext: resourceIPv6FixedAddressUpdate,
+		DeleteContext: resourceIPv6FixedAddressDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customdiff.Sequence(
+			makeEACustomDiff("extensible_attributes"),
+		),
+		Schema: map[string]*schema.Schema{
+			"address_type": {
+				Type:        schema.TypeString,
+				Description: "The address type of the fixed address: ADDRESS, PREFIX or BOTH.",
+				Computed:    true,
+			},
+			"cidr": {
+				Type:             schema.TypeString,
+				Description:      "The network to which this fixed address belongs, in IPv6 Address/CIDR format. When ip_address is not set the next available address of this network is reserved.",
+				Optional:         true,
+				ForceNew:         true,
+				Computed:         true,
+				AtLeastOneOf:     ipv6FixedAddressRequiredFields,
+				ValidateDiagFunc: validation.ToDiagFunc(validateIPv6CIDR),
+				DiffSuppressFunc: ipv6CIDRSuppressDiff,
+			},
+			"comment": {
+				Type:             schema.TypeString,
+				Description:      "Comment for the fixed address; maximum 256 characters.",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 256)),
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Description: "Determines whether a fixed address is disabled or not. When this is set to False, the fixed address is enabled.",
+				Optional:    true,
+				Default:     false,
+			},
+			"duid": {
+				Type:             schema.TypeString,
+				Description:      "The DHCPv6 Unique Identifier (DUID) of the client, as colon separated hex bytes.",
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(duidRegex, "must be colon separated hex bytes")),
+				StateFunc: func(val interface{}) string {
+					return strings.ToLower(val.(string))
+				},
+			},
+			"extensible_attribute": extensibleAttributeBlockSchema(),
+			"extensible_attributes": {
+				Type:             schema.TypeMap,
+				Description:      "Extensible attributes of IPv6 fixed address (Values are JSON encoded).",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateEa,
+				DiffSuppressFunc: eaSuppressDiff,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"grid_ref": {
+				Type:         schema.TypeString,
+				Description:  "Ref for grid needed for restarting services.",
+				Optional:     true,
+				RequiredWith: []string{"restart_if_needed"},
+			},
+			"hostname": {
+				Type:        schema.TypeString,
+				Description: "This field contains the name of this fixed address.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"ip_address": {
+				Type:             schema.TypeString,
+				Description:      "The IPv6 Address of the fixed address.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPv6Address),
+				DiffSuppressFunc: ipv6AddressSuppressDiff,
+				Optional:         true,
+				Computed:         true,
+				AtLeastOneOf:     ipv6FixedAddressRequiredFields,
+			},
+			"ipv6_prefix": {
+				Type:             schema.TypeString,
+				Description:      "The IPv6 prefix delegated to the client.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPv6Address),
+				DiffSuppressFunc: ipv6AddressSuppressDiff,
+				Optional:         true,
+				AtLeastOneOf:     ipv6FixedAddressRequiredFields,
+				RequiredWith:     []string{"prefix_length"},
+			},
+			"member": {
+				Type:        schema.TypeList,
+				Description: "Grid member serving the fixed address, used when restarting services.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"struct": {
+							Type:             schema.TypeString,
+							Description:      "Struct type of member.",
+							Optional:         true,
+							Default:          "dhcpmember",
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"dhcpmember"}, true)),
+							StateFunc: func(val interface{}) string {
+								return strings.ToLower(val.(string))
+							},
+						},
+						"ip_v6_address": {
+							Type:             schema.TypeString,
+							Description:      "IPv6 address.",
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPv6Address),
+						},
+						"hostname": {
+							Type:        schema.TypeString,
+							Description: "Hostname of member.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Description: "The name of the network view in which this fixed address resides.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"option": {
+				Type:        schema.TypeSet,
+				Description: "An array of DHCPv6 option structs that lists the DHCP options associated with the object.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the DHCPv6 option.",
+							Required:    true,
+						},
+						"code": {
+							Type:        schema.TypeInt,
+							Description: "The code of the DHCPv6 option.",
+							Required:    true,
+						},
+						"use_option": {
+							Type:        schema.TypeBool,
+							Description: "Only applies to special options that are displayed separately from other options and have a use flag.",
+							Optional:    true,
+							Default:     true,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Description: "Value of the DHCPv6 option.",
+							Required:    true,
+						},
+						"vendor_class": {
+							Type:        schema.TypeString,
+							Description: "The name of the space this DHCPv6 option is associated to.",
+							Optional:    true,
+							Default:     "DHCPv6",
+						},
+					},
+				},
+			},
+			"prefix_length": {
+				Type:             schema.TypeInt,
+				Description:      "The prefix length of the delegated ipv6_prefix.",
+				Optional:         true,
+				RequiredWith:     []string{"ipv6_prefix"},
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 128)),
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Description: "Reference id of IPv6 fixed address object.",
+				Computed:    true,
+			},
+			"restart_if_needed": {
+				Type:        schema.TypeBool,
+				Description: "Restart dhcpv6 services if needed.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// ipv6FixedAddressType returns the address_type matching the address and
+// prefix configured on the fixed address
+func ipv6FixedAddressType(hasAddress bool, hasPrefix bool) string {
+	switch {
+	case hasAddress && hasPrefix:
+		return "BOTH"
+	case hasPrefix:
+		return "PREFIX"
+	}
+	return "ADDRESS"
+}
+
+func convertIPv6FixedAddressToResourceData(client *infoblox.Client, d *schema.ResourceData, fixedAddress *infoblox.IPv6FixedAddress) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.Set("ref", fixedAddress.Ref)
+	d.Set("cidr", fixedAddress.CIDR)
+	d.Set("ip_address", fixedAddress.IPAddress)
+	d.Set("duid", fixedAddress.Duid)
+	d.Set("ipv6_prefix", fixedAddress.IPv6Prefix)
+	if fixedAddress.IPv6PrefixBits != nil && fixedAddress.IPv6Prefix != "" {
+		d.Set("prefix_length", *fixedAddress.IPv6PrefixBits)
+	} else {
+		d.Set("prefix_length", nil)
+	}
+	d.Set("address_type", fixedAddress.AddressType)
+	d.Set("hostname", fixedAddress.Hostname)
+	d.Set("comment", fixedAddress.Comment)
+	d.Set("disable", fixedAddress.Disable)
+	d.Set("network_view", fixedAddress.NetworkView)
+
+	var optionList []map[string]interface{}
+	for _, option := range fixedAddress.Options {
+		optionList = append(optionList, map[string]interface{}{
+			"name":         option.Name,
+			"code":         option.Code,
+			"use_option":   option.UseOption != nil && *option.UseOption,
+			"value":        option.Value,
+			"vendor_class": option.VendorClass,
+		})
+	}
+
+	d.Set("option", optionList)
+
+	eas, err := client.ConvertEAsToJSONString(*fixedAddress.ExtensibleAttributes)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	} else {
+		d.Set("extensible_attributes", eas)
+	}
+
+	return diags
+}
+
+func convertResourceDataToIPv6FixedAddress(client *infoblox.Client, d *schema.ResourceData) (*infoblox.IPv6FixedAddress, error) {
+	var fixedAddress infoblox.IPv6FixedAddress
+
+	fixedAddress.CIDR = d.Get("cidr").(string)
+	fixedAddress.Duid = strings.ToLower(d.Get("duid").(string))
+	fixedAddress.Hostname = d.Get("hostname").(string)
+	fixedAddress.Comment = d.Get("comment").(string)
+	fixedAddress.Disable = newBool(d.Get("disable").(bool))
+	fixedAddress.NetworkView = d.Get("network_view").(string)
+	fixedAddress.Options = convertOptionList(d.Get("option").(*schema.Set).List())
+
+	if prefix, ok := d.GetOk("ipv6_prefix"); ok {
+		fixedAddress.IPv6Prefix = prefix.(string)
+		fixedAddress.IPv6PrefixBits = newInt(d.Get("prefix_length").(int))
+	}
+
+	if ipAddress, ok := d.GetOk("ip_address"); ok {
+		fixedAddress.IPAddress = ipAddress.(string)
+	} else if cidr, ok := d.GetOk("cidr"); ok {
+		if fixedAddress.NetworkView != "" {
+			fixedAddress.IPAddress = fmt.Sprintf("func:nextavailableip:%s,%s", cidr.(string), fixedAddress.NetworkView)
+		} else {
+			fixedAddress.IPAddress = fmt.Sprintf("func:nextavailableip:%s", cidr.(string))
+		}
+	}
+	fixedAddress.AddressType = ipv6FixedAddressType(fixedAddress.IPAddress != "", fixedAddress.IPv6Prefix != "")
+
+	eaMap := d.Get("extensible_attributes").(map[string]interface{})
+	if len(eaMap) > 0 {
+		eas, err := createExtensibleAttributesFromJSON(eaMap)
+		if err != nil {
+			return &fixedAddress, err
+		}
+		fixedAddress.ExtensibleAttributes = &eas
+	}
+
+	if client.OrchestratorEAs != nil && len(*client.OrchestratorEAs) > 0 {
+		if fixedAddress.ExtensibleAttributes == nil {
+			fixedAddress.ExtensibleAttributes = &infoblox.ExtensibleAttribute{}
+		}
+		for k, v := range *client.OrchestratorEAs {
+			(*fixedAddress.ExtensibleAttributes)[k] = v
+		}
+	}
+
+	return &fixedAddress, nil
+}
+
+func resourceIPv6FixedAddressRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	fixedAddress, err := client.GetIPv6FixedAddressByRef(ref, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] IPv6 fixed address %s not found, removing from state", ref)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	check := convertIPv6FixedAddressToResourceData(client, d, &fixedAddress)
+	if check.HasError() {
+		return check
+	}
+
+	d.SetId(fixedAddress.Ref)
+
+	return diags
+}
+
+func resourceIPv6FixedAddressCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+
+	fixedAddress, err := convertResourceDataToIPv6FixedAddress(client, d)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	err = client.CreateIPv6FixedAddress(fixedAddress)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	d.SetId(fixedAddress.Ref)
+
+	err = restartIPv6NetworkMember(client, d, convertIPv6MemberList(d.Get("member").([]interface{})))
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	return resourceIPv6FixedAddressRead(ctx, d, m)
+}
+
+func resourceIPv6FixedAddressUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*infoblox.Client)
+
+	var fixedAddress infoblox.IPv6FixedAddress
+
+	if d.HasChange("ip_address") {
+		fixedAddress.IPAddress = d.Get("ip_address").(string)
+	}
+	if d.HasChange("duid") {
+		fixedAddress.Duid = strings.ToLower(d.Get("duid").(string))
+	}
+	if d.HasChanges("ip_address", "ipv6_prefix", "prefix_length") {
+		fixedAddress.IPv6Prefix = d.Get("ipv6_prefix").(string)
+		if fixedAddress.IPv6Prefix != "" {
+			fixedAddress.IPv6PrefixBits = newInt(d.Get("prefix_length").(int))
+		}
+		fixedAddress.AddressType = ipv6FixedAddressType(d.Get("ip_address").(string) != "", fixedAddress.IPv6Prefix != "")
+	}
+	if d.HasChange("hostname") {
+		fixedAddress.Hostname = d.Get("hostname").(string)
+	}
+	if d.HasChange("comment") {
+		fixedAddress.Comment = d.Get("comment").(string)
+	}
+	if d.HasChange("disable") {
+		fixedAddress.Disable = newBool(d.Get("disable").(bool))
+	}
+	if d.HasChange("option") {
+		fixedAddress.Options = convertOptionList(d.Get("option").(*schema.Set).List())
+	}
+	if d.HasChange("extensible_attributes") {
+		old, new := d.GetChange("extensible_attributes")
+		oldKeys := Keys(old.(map[string]interface{}))
+		oldEAs, err := createExtensibleAttributesFromJSON(old.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		newKeys := Keys(new.(map[string]interface{}))
+		newEAs, err := createExtensibleAttributesFromJSON(new.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		removeEAs := sliceDiff(oldKeys, newKeys, false)
+		if len(removeEAs) > 0 {
+			fixedAddress.ExtensibleAttributesRemove = &infoblox.ExtensibleAttribute{}
+			for _, v := range removeEAs {
+				(*fixedAddress.ExtensibleAttributesRemove)[v] = oldEAs[v]
+			}
+		}
+		for k, v := range newEAs {
+			if !Contains(oldKeys, k) || (Contains(oldKeys, k) && v.Value != oldEAs[k].Value) {
+				if fixedAddress.ExtensibleAttributesAdd == nil {
+					fixedAddress.ExtensibleAttributesAdd = &infoblox.ExtensibleAttribute{}
+				}
+				(*fixedAddress.ExtensibleAttributesAdd)[k] = v
+			}
+		}
+		if client.OrchestratorEAs != nil && len(*client.OrchestratorEAs) > 0 {
+			if fixedAddress.ExtensibleAttributesAdd == nil {
+				fixedAddress.ExtensibleAttributesAdd = &infoblox.ExtensibleAttribute{}
+			}
+			for k, v := range *client.OrchestratorEAs {
+				(*fixedAddress.ExtensibleAttributesAdd)[k] = v
+			}
+		}
+	}
+
+	changedFixedAddress, err := client.UpdateIPv6FixedAddress(d.Id(), fixedAddress)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	d.SetId(changedFixedAddress.Ref)
+
+	err = restartIPv6NetworkMember(client, d, convertIPv6MemberList(d.Get("member").([]interface{})))
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	return resourceIPv6FixedAddressRead(ctx, d, m)
+}
+
+func resourceIPv6FixedAddressDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	err := client.DeleteIPv6FixedAddress(ref)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = restartIPv6NetworkMember(client, d, convertIPv6MemberList(d.Get("member").([]interface{})))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
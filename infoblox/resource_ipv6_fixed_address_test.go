@@ -0,0 +1,231 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccInfobloxIPv6FixedAddressBasic(t *testing.T) {
+	testAccSteps(t, testInfobloxIPv6FixedAddressSteps)
+}
+
+func TestUnitInfobloxIPv6FixedAddressBasic(t *testing.T) {
+	testUnitSteps(t, testInfobloxIPv6FixedAddressSteps)
+}
+
+func testInfobloxIPv6FixedAddressSteps(providerConfig string) []resource.TestStep {
+	return []resource.TestStep{
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxIPv6FixedAddressCreate()),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxIPv6FixedAddressExists("infoblox_ipv6_fixed_address.new"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.new", "ip_address", "2001:db8:300::1"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.new", "cidr", "2001:db8:300::/64"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.new", "address_type", "ADDRESS"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.new", "comment", "test ipv6 fixed address"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation\",\"type\":\"STRING\"}"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.new", "extensible_attributes.Orchestrator", "{\"value\":\"Terraform\",\"type\":\"ENUM\"}"),
+				testAccCheckInfobloxIPv6FixedAddressExists("infoblox_ipv6_fixed_address.prefix"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.prefix", "address_type", "PREFIX"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.prefix", "prefix_length", "56"),
+			),
+		},
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxIPv6FixedAddressUpdate()),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.new", "ip_address", "2001:db8:300::1"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.new", "comment", "test ipv6 fixed address update"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation2\",\"type\":\"STRING\"}"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.prefix", "address_type", "BOTH"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_fixed_address.prefix", "ip_address", "2001:db8:300::20"),
+			),
+		},
+	}
+}
+
+func TestUnitResourceIPv6FixedAddressAllocation(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	gridRef := fake.refs("grid")[0]
+	fake.create("ipv6network", map[string]interface{}{
+		"network": "2001:db8:400::/64",
+	})
+	fake.create("ipv6fixedaddress", map[string]interface{}{
+		"ipv6addr": "2001:db8:400::1",
+		"duid":     "00:01:00:01:aa:bb:cc:dd:00:00:00:01",
+	})
+
+	r := resourceIPv6FixedAddress()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"cidr":              "2001:db8:400::/64",
+		"duid":              "00:01:00:01:AA:BB:CC:DD:00:00:00:02",
+		"grid_ref":          gridRef,
+		"restart_if_needed": true,
+		"member": []interface{}{
+			map[string]interface{}{"hostname": "infoblox1.example.com"},
+		},
+		"option": []interface{}{
+			map[string]interface{}{"name": "domain-search-list", "code": 24, "value": "example.com"},
+		},
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	if ip := d.Get("ip_address").(string); ip != "2001:db8:400::2" {
+		t.Fatalf("expected the next free address to be reserved, found %s", ip)
+	}
+	if duid := d.Get("duid").(string); duid != "00:01:00:01:aa:bb:cc:dd:00:00:00:02" {
+		t.Fatalf("expected the duid to be normalized to lower case, found %s", duid)
+	}
+	fixedAddress, ok := fake.lookup(d.Id())
+	if !ok {
+		t.Fatalf("create: fixed address %s not found in fake grid", d.Id())
+	}
+	options := fixedAddress["options"].([]interface{})
+	if vendorClass := options[0].(map[string]interface{})["vendor_class"]; vendorClass != "DHCPv6" {
+		t.Fatalf("expected options to default to the DHCPv6 space, found %v", vendorClass)
+	}
+	restarts := fake.restartRequests()
+	if len(restarts) != 1 || fmt.Sprint(restarts[0]["services"]) != "[DHCPV6]" {
+		t.Fatalf("expected a DHCPv6 restart, found %v", restarts)
+	}
+
+	// Adding a delegated prefix to an address reservation switches it to BOTH
+	state := d.State()
+	d, err := schema.InternalMap(r.Schema).Data(state, &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"ipv6_prefix":   {Old: "", New: "2001:db8:500::"},
+			"prefix_length": {Old: "", New: "56"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	fixedAddress, _ = fake.lookup(d.Id())
+	if fixedAddress["address_type"] != "BOTH" || fmt.Sprint(fixedAddress["ipv6prefix_bits"]) != "56" {
+		t.Fatalf("expected a BOTH reservation with a /56 prefix, found %v/%v", fixedAddress["address_type"], fixedAddress["ipv6prefix_bits"])
+	}
+	if len(fake.restartRequests()) != 2 {
+		t.Fatalf("expected update to restart DHCPv6, found %v", fake.restartRequests())
+	}
+}
+
+func TestUnitResourceIPv6FixedAddressCanonicalAddress(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	fake.create("ipv6network", map[string]interface{}{
+		"network": "2001:db8:410::/64",
+	})
+	r := resourceIPv6FixedAddress()
+	config := map[string]interface{}{
+		"cidr":          "2001:DB8:0410::/64",
+		"ip_address":    "2001:DB8:410:0::0005",
+		"ipv6_prefix":   "2001:0DB8:0510:0000::",
+		"prefix_length": 56,
+		"duid":          "00:01:00:01:aa:bb:cc:dd:00:00:00:05",
+	}
+	if diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"cidr": "10.41.0.0/24",
+		"duid": "00:01:00:01:aa:bb:cc:dd:00:00:00:05",
+	})); !diags.HasError() {
+		t.Fatal("expected an IPv4 cidr to be rejected")
+	}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, config)
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	if ip := d.Get("ip_address").(string); ip != "2001:db8:410::5" {
+		t.Fatalf("expected the canonical address to be read back, found %s", ip)
+	}
+
+	// The grid rewrites the address and prefix, which must not show as changes
+	diff, err := r.SimpleDiff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatalf("plan: %s", err)
+	}
+	if diff != nil {
+		for _, key := range []string{"cidr", "ip_address", "ipv6_prefix"} {
+			if attr := diff.Attributes[key]; attr != nil {
+				t.Errorf("expected no change of %s for a non-canonical value, found %+v", key, attr)
+			}
+		}
+	}
+}
+
+func testAccCheckInfobloxIPv6FixedAddressExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource: %s not set", resourceName)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckInfobloxIPv6FixedAddressCreate() string {
+	return `
+  resource "infoblox_ipv6_network" "new" {
+    cidr    = "2001:db8:300::/64"
+    comment = "test ipv6 fixed address network"
+  }
+  resource "infoblox_ipv6_fixed_address" "new" {
+    cidr     = infoblox_ipv6_network.new.cidr
+    duid     = "00:01:00:01:2a:3b:4c:5d:12:34:56:78:9a:bc"
+    hostname = "ipv6-fixed-address"
+    comment  = "test ipv6 fixed address"
+    extensible_attributes = {
+      Location = jsonencode({
+        value = "CollegeStation",
+        type  = "STRING"
+      })
+    }
+  }
+  resource "infoblox_ipv6_fixed_address" "prefix" {
+    duid          = "00:01:00:01:2a:3b:4c:5d:12:34:56:78:9a:bd"
+    ipv6_prefix   = "2001:db8:600::"
+    prefix_length = 56
+  }
+`
+}
+
+func testAccCheckInfobloxIPv6FixedAddressUpdate() string {
+	return `
+  resource "infoblox_ipv6_network" "new" {
+    cidr    = "2001:db8:300::/64"
+    comment = "test ipv6 fixed address network"
+  }
+  resource "infoblox_ipv6_fixed_address" "new" {
+    cidr     = infoblox_ipv6_network.new.cidr
+    duid     = "00:01:00:01:2a:3b:4c:5d:12:34:56:78:9a:bc"
+    hostname = "ipv6-fixed-address"
+    comment  = "test ipv6 fixed address update"
+    extensible_attributes = {
+      Location = jsonencode({
+        value = "CollegeStation2",
+        type  = "STRING"
+      })
+    }
+  }
+  resource "infoblox_ipv6_fixed_address" "prefix" {
+    duid          = "00:01:00:01:2a:3b:4c:5d:12:34:56:78:9a:bd"
+    ip_address    = "2001:db8:300::20"
+    ipv6_prefix   = "2001:db8:600::"
+    prefix_length = 56
+  }
+`
+}
@@ -0,0 +1,498 @@
+package infoblox
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+func resourceIPv6Network() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIPv6NetworkCreate,
+		ReadContext:   resourceIPv6NetworkRead,
+		UpdateContext: resourceIPv6NetworkUpdate,
+		DeleteContext: resourceIPv6NetworkDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customdiff.Sequence(
+			makeEACustomDiff("extensible_attributes"),
+		),
+		Schema: map[string]*schema.Schema{
+			"cidr": {
+				Type:             schema.TypeString,
+				Description:      "The network address in IPv6 Address/CIDR format.",
+				Optional:         true,
+				Computed:         true,
+				AtLeastOneOf:     []string{"cidr", "parent_cidr", "ea_search"},
+				ConflictsWith:    []string{"ea_search", "parent_cidr"},
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validateIPv6CIDR),
+				DiffSuppressFunc: ipv6CIDRSuppressDiff,
+			},
+			"comment": {
+				Type:             schema.TypeString,
+				Description:      "Comment for the network; maximum 256 characters.",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 256)),
+			},
+			"disable_dhcp": {
+				Type:        schema.TypeBool,
+				Description: "Disable for DHCP.",
+				Optional:    true,
+				Default:     false,
+			},
+			"ea_search": {
+				Type:          schema.TypeMap,
+				Description:   "Ea search criteria for next_available_network function",
+				Optional:      true,
+				ConflictsWith: []string{"cidr", "parent_cidr"},
+				AtLeastOneOf:  []string{"cidr", "parent_cidr", "ea_search"},
+				RequiredWith:  []string{"prefix_length"},
+				ForceNew:      true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"extensible_attribute": extensibleAttributeBlockSchema(),
+			"extensible_attributes": {
+				Type:             schema.TypeMap,
+				Description:      "Extensible attributes of IPv6 network (Values are JSON encoded).",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateEa,
+				DiffSuppressFunc: eaSuppressDiff,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"grid_ref": {
+				Type:         schema.TypeString,
+				Description:  "Ref for grid needed for restarting services.",
+				Optional:     true,
+				RequiredWith: []string{"restart_if_needed"},
+			},
+			"member": {
+				Type:        schema.TypeList,
+				Description: "Grid members associated with network.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"struct": {
+							Type:             schema.TypeString,
+							Description:      "Struct type of member.",
+							Optional:         true,
+							Default:          "dhcpmember",
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"dhcpmember"}, true)),
+							StateFunc: func(val interface{}) string {
+								return strings.ToLower(val.(string))
+							},
+						},
+						"ip_v6_address": {
+							Type:             schema.TypeString,
+							Description:      "IPv6 address.",
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPv6Address),
+						},
+						"hostname": {
+							Type:        schema.TypeString,
+							Description: "Hostname of member.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"network_view": {
+				Type:        schema.TypeString,
+				Description: "The name of the network view in which this network resides.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"option": {
+				Type:        schema.TypeSet,
+				Description: "An array of DHCPv6 option structs that lists the DHCP options associated with the object.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the DHCPv6 option.",
+							Required:    true,
+						},
+						"code": {
+							Type:        schema.TypeInt,
+							Description: "The code of the DHCPv6 option.",
+							Required:    true,
+						},
+						"use_option": {
+							Type:        schema.TypeBool,
+							Description: "Only applies to special options that are displayed separately from other options and have a use flag.",
+							Optional:    true,
+							Default:     true,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Description: "Value of the DHCPv6 option.",
+							Required:    true,
+						},
+						"vendor_class": {
+							Type:        schema.TypeString,
+							Description: "The name of the space this DHCPv6 option is associated to.",
+							Optional:    true,
+							Default:     "DHCPv6",
+						},
+					},
+				},
+			},
+			"parent_cidr": {
+				Type:             schema.TypeString,
+				Description:      "Parent IPv6 container CIDR subnet",
+				Optional:         true,
+				AtLeastOneOf:     []string{"cidr", "parent_cidr", "ea_search"},
+				ConflictsWith:    []string{"cidr", "ea_search"},
+				RequiredWith:     []string{"prefix_length"},
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validateIPv6CIDR),
+				DiffSuppressFunc: ipv6CIDRSuppressDiff,
+			},
+			"prefix_length": {
+				Type:             schema.TypeInt,
+				Description:      "Desired prefix size of requested network",
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 128)),
+				ForceNew:         true,
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Description: "Reference id of IPv6 network object.",
+				Computed:    true,
+			},
+			"restart_if_needed": {
+				Type:        schema.TypeBool,
+				Description: "Restart dhcp services if needed.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// ipv6NextAvailableNetwork returns the function allocating the next available
+// network from the IPv6 container matched by parent_cidr or ea_search
+func ipv6NextAvailableNetwork(d *schema.ResourceData) infoblox.NetworkContainerFunction {
+	objectParameters := make(map[string]string)
+	if parentCIDR := d.Get("parent_cidr").(string); parentCIDR != "" {
+		objectParameters["network"] = parentCIDR
+	} else {
+		for k, v := range d.Get("ea_search").(map[string]interface{}) {
+			objectParameters[k] = v.(string)
+		}
+	}
+	if networkView := d.Get("network_view").(string); networkView != "" {
+		objectParameters["network_view"] = networkView
+	}
+	return infoblox.NetworkContainerFunction{
+		Function:         "next_available_network",
+		ResultField:      "networks",
+		Object:           "ipv6networkcontainer",
+		ObjectParameters: objectParameters,
+		Parameters: map[string]int{
+			"cidr": d.Get("prefix_length").(int),
+		},
+	}
+}
+
+func convertIPv6MemberList(memberList []interface{}) []infoblox.Member {
+	members := []infoblox.Member{}
+	for _, member := range memberList {
+		members = append(members, infoblox.Member{
+			StructType:  member.(map[string]interface{})["struct"].(string),
+			Hostname:    member.(map[string]interface{})["hostname"].(string),
+			IPV6Address: member.(map[string]interface{})["ip_v6_address"].(string),
+		})
+	}
+	return members
+}
+
+func convertOptionList(optionList []interface{}) []infoblox.Option {
+	options := []infoblox.Option{}
+	for _, option := range optionList {
+		options = append(options, infoblox.Option{
+			Name:        option.(map[string]interface{})["name"].(string),
+			Code:        option.(map[string]interface{})["code"].(int),
+			UseOption:   newBool(option.(map[string]interface{})["use_option"].(bool)),
+			Value:       option.(map[string]interface{})["value"].(string),
+			VendorClass: option.(map[string]interface{})["vendor_class"].(string),
+		})
+	}
+	return options
+}
+
+func convertIPv6NetworkToResourceData(client *infoblox.Client, d *schema.ResourceData, network *infoblox.IPv6Network) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.Set("ref", network.Ref)
+	d.Set("cidr", network.CIDR)
+	d.Set("comment", network.Comment)
+	d.Set("disable_dhcp", network.Disable != nil && *network.Disable)
+	d.Set("network_view", network.NetworkView)
+
+	var memberList []map[string]interface{}
+	var members []infoblox.Member
+	if network.Members != nil {
+		members = *network.Members
+	}
+	for _, member := range members {
+		memberList = append(memberList, map[string]interface{}{
+			"struct":        member.StructType,
+			"hostname":      member.Hostname,
+			"ip_v6_address": member.IPV6Address,
+		})
+	}
+
+	d.Set("member", memberList)
+
+	var optionList []map[string]interface{}
+	var options []infoblox.Option
+	if network.Options != nil {
+		options = *network.Options
+	}
+	for _, option := range options {
+		optionList = append(optionList, map[string]interface{}{
+			"name":         option.Name,
+			"code":         option.Code,
+			"use_option":   option.UseOption != nil && *option.UseOption,
+			"value":        option.Value,
+			"vendor_class": option.VendorClass,
+		})
+	}
+
+	d.Set("option", optionList)
+
+	eas, err := client.ConvertEAsToJSONString(*network.ExtensibleAttributes)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	} else {
+		d.Set("extensible_attributes", eas)
+	}
+
+	return diags
+}
+
+func convertResourceDataToIPv6Network(client *infoblox.Client, d *schema.ResourceData) (*infoblox.IPv6Network, error) {
+	var network infoblox.IPv6Network
+
+	network.CIDR = d.Get("cidr").(string)
+	network.Comment = d.Get("comment").(string)
+	network.Disable = newBool(d.Get("disable_dhcp").(bool))
+	network.NetworkView = d.Get("network_view").(string)
+	members := convertIPv6MemberList(d.Get("member").([]interface{}))
+	network.Members = &members
+	options := convertOptionList(d.Get("option").(*schema.Set).List())
+	network.Options = &options
+
+	eaMap := d.Get("extensible_attributes").(map[string]interface{})
+	if len(eaMap) > 0 {
+		eas, err := createExtensibleAttributesFromJSON(eaMap)
+		if err != nil {
+			return &network, err
+		}
+		network.ExtensibleAttributes = &eas
+	}
+
+	if client.OrchestratorEAs != nil && len(*client.OrchestratorEAs) > 0 {
+		if network.ExtensibleAttributes == nil {
+			network.ExtensibleAttributes = &infoblox.ExtensibleAttribute{}
+		}
+		for k, v := range *client.OrchestratorEAs {
+			(*network.ExtensibleAttributes)[k] = v
+		}
+	}
+
+	return &network, nil
+}
+
+func restartIPv6NetworkMember(client *infoblox.Client, d *schema.ResourceData, members []infoblox.Member) error {
+	if !d.Get("restart_if_needed").(bool) || len(members) != 1 {
+		return nil
+	}
+	return client.RestartServices(d.Get("grid_ref").(string), infoblox.GridServiceRestartRequest{
+		RestartOption: "RESTART_IF_NEEDED",
+		Services:      []string{"DHCPV6"},
+		Members:       []string{members[0].Hostname},
+	})
+}
+
+func resourceIPv6NetworkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	network, err := client.GetIPv6NetworkByRef(ref, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] IPv6 network %s not found, removing from state", ref)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	check := convertIPv6NetworkToResourceData(client, d, &network)
+	if check.HasError() {
+		return check
+	}
+
+	d.SetId(network.Ref)
+
+	return diags
+}
+
+func resourceIPv6NetworkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+
+	network, err := convertResourceDataToIPv6Network(client, d)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	if network.CIDR == "" {
+		result, err := client.CreateIPv6NetworkFromContainer(&infoblox.NetworkFromContainer{
+			NetworkView:          network.NetworkView,
+			Network:              ipv6NextAvailableNetwork(d),
+			Comment:              network.Comment,
+			DisableDHCP:          network.Disable,
+			Members:              *network.Members,
+			Options:              *network.Options,
+			ExtensibleAttributes: network.ExtensibleAttributes,
+		})
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+		network = &result
+	} else {
+		err = client.CreateIPv6Network(network)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+	}
+
+	d.SetId(network.Ref)
+
+	err = restartIPv6NetworkMember(client, d, convertIPv6MemberList(d.Get("member").([]interface{})))
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	return resourceIPv6NetworkRead(ctx, d, m)
+}
+
+func resourceIPv6NetworkUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*infoblox.Client)
+
+	var network infoblox.IPv6Network
+
+	if d.HasChange("comment") {
+		network.Comment = d.Get("comment").(string)
+	}
+	if d.HasChange("disable_dhcp") {
+		network.Disable = newBool(d.Get("disable_dhcp").(bool))
+	}
+	// Members and options are pointers so that clearing them sends an empty list
+	if d.HasChange("member") {
+		members := convertIPv6MemberList(d.Get("member").([]interface{}))
+		network.Members = &members
+	}
+	if d.HasChange("option") {
+		options := convertOptionList(d.Get("option").(*schema.Set).List())
+		network.Options = &options
+	}
+	if d.HasChange("extensible_attributes") {
+		old, new := d.GetChange("extensible_attributes")
+		oldKeys := Keys(old.(map[string]interface{}))
+		oldEAs, err := createExtensibleAttributesFromJSON(old.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		newKeys := Keys(new.(map[string]interface{}))
+		newEAs, err := createExtensibleAttributesFromJSON(new.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		removeEAs := sliceDiff(oldKeys, newKeys, false)
+		if len(removeEAs) > 0 {
+			network.ExtensibleAttributesRemove = &infoblox.ExtensibleAttribute{}
+			for _, v := range removeEAs {
+				(*network.ExtensibleAttributesRemove)[v] = oldEAs[v]
+			}
+		}
+		for k, v := range newEAs {
+			if !Contains(oldKeys, k) || (Contains(oldKeys, k) && v.Value != oldEAs[k].Value) {
+				if network.ExtensibleAttributesAdd == nil {
+					network.ExtensibleAttributesAdd = &infoblox.ExtensibleAttribute{}
+				}
+				(*network.ExtensibleAttributesAdd)[k] = v
+			}
+		}
+		if client.OrchestratorEAs != nil && len(*client.OrchestratorEAs) > 0 {
+			if network.ExtensibleAttributesAdd == nil {
+				network.ExtensibleAttributesAdd = &infoblox.ExtensibleAttribute{}
+			}
+			for k, v := range *client.OrchestratorEAs {
+				(*network.ExtensibleAttributesAdd)[k] = v
+			}
+		}
+	}
+
+	changedNetwork, err := client.UpdateIPv6Network(d.Id(), network)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	d.SetId(changedNetwork.Ref)
+
+	err = restartIPv6NetworkMember(client, d, convertIPv6MemberList(d.Get("member").([]interface{})))
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	return resourceIPv6NetworkRead(ctx, d, m)
+}
+
+func resourceIPv6NetworkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	err := client.DeleteIPv6Network(ref)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = restartIPv6NetworkMember(client, d, convertIPv6MemberList(d.Get("member").([]interface{})))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
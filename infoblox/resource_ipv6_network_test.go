@@ -0,0 +1,282 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccInfobloxIPv6NetworkBasic(t *testing.T) {
+	testAccSteps(t, testInfobloxIPv6NetworkSteps)
+}
+
+func TestUnitInfobloxIPv6NetworkBasic(t *testing.T) {
+	testUnitSteps(t, testInfobloxIPv6NetworkSteps)
+}
+
+func testInfobloxIPv6NetworkSteps(providerConfig string) []resource.TestStep {
+	return []resource.TestStep{
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxIPv6NetworkCreate()),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxIPv6NetworkExists("infoblox_ipv6_container.new"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_container.new", "cidr", "2001:db8:100::/48"),
+				testAccCheckInfobloxIPv6NetworkExists("infoblox_ipv6_container.site"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_container.site", "cidr", "2001:db8:100::/56"),
+				testAccCheckInfobloxIPv6NetworkExists("infoblox_ipv6_network.new"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_network.new", "cidr", "2001:db8:100::/64"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_network.new", "comment", "test ipv6 network"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_network.new", "option.#", "1"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_network.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation\",\"type\":\"STRING\"}"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_network.new", "extensible_attributes.Orchestrator", "{\"value\":\"Terraform\",\"type\":\"ENUM\"}"),
+				testAccCheckInfobloxIPv6NetworkExists("infoblox_ipv6_network.ea"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_network.ea", "cidr", "2001:db8:100:1::/64"),
+			),
+		},
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxIPv6NetworkUpdate()),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("infoblox_ipv6_network.new", "cidr", "2001:db8:100::/64"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_network.new", "comment", "test ipv6 network update"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_network.new", "option.#", "0"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_network.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation2\",\"type\":\"STRING\"}"),
+				resource.TestCheckResourceAttr("infoblox_ipv6_container.site", "comment", "test ipv6 site update"),
+			),
+		},
+	}
+}
+
+func TestUnitResourceIPv6NetworkAllocation(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	gridRef := fake.refs("grid")[0]
+	fake.create("ipv6networkcontainer", map[string]interface{}{
+		"network": "2001:db8:200::/48",
+	})
+	fake.create("ipv6network", map[string]interface{}{
+		"network": "2001:db8:200::/64",
+	})
+
+	r := resourceIPv6Network()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"parent_cidr":       "2001:db8:200::/48",
+		"prefix_length":     64,
+		"grid_ref":          gridRef,
+		"restart_if_needed": true,
+		"member": []interface{}{
+			map[string]interface{}{"hostname": "infoblox1.example.com"},
+		},
+		"option": []interface{}{
+			map[string]interface{}{"name": "domain-search-list", "code": 24, "value": "example.com"},
+		},
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	if cidr := d.Get("cidr").(string); cidr != "2001:db8:200:1::/64" {
+		t.Fatalf("expected the next free /64 to be allocated, found %s", cidr)
+	}
+	network, ok := fake.lookup(d.Id())
+	if !ok {
+		t.Fatalf("create: network %s not found in fake grid", d.Id())
+	}
+	options := network["options"].([]interface{})
+	if vendorClass := options[0].(map[string]interface{})["vendor_class"]; vendorClass != "DHCPv6" {
+		t.Fatalf("expected options to default to the DHCPv6 space, found %v", vendorClass)
+	}
+	members := network["members"].([]interface{})
+	if structType := members[0].(map[string]interface{})["_struct"]; structType != "dhcpmember" {
+		t.Fatalf("expected a dhcpmember struct, found %v", structType)
+	}
+	restarts := fake.restartRequests()
+	if len(restarts) != 1 || fmt.Sprint(restarts[0]["services"]) != "[DHCPV6]" {
+		t.Fatalf("expected a DHCPv6 restart, found %v", restarts)
+	}
+
+	// A prefix longer than the container allows is rejected by the grid
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"parent_cidr":   "2001:db8:200::/48",
+		"prefix_length": 32,
+	})
+	if diags := r.CreateContext(context.Background(), d, client); !diags.HasError() {
+		t.Fatal("expected allocating a /32 from a /48 to fail")
+	}
+}
+
+func TestUnitResourceIPv6NetworkClearMembersAndOptions(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	r := resourceIPv6Network()
+
+	d := testUnitPlanCreate(t, r, map[string]interface{}{
+		"cidr": "2001:db8:700::/64",
+		"member": []interface{}{
+			map[string]interface{}{"hostname": "infoblox1.example.com"},
+		},
+		"option": []interface{}{
+			map[string]interface{}{"name": "domain-search-list", "code": 24, "value": "example.com"},
+		},
+	}, client)
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+
+	d = testUnitPlanUpdate(t, r, d, map[string]interface{}{
+		"cidr": "2001:db8:700::/64",
+	}, client)
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	network, _ := fake.lookup(d.Id())
+	if members, _ := network["members"].([]interface{}); len(members) != 0 {
+		t.Fatalf("expected the members to be cleared, found %v", members)
+	}
+	if options, _ := network["options"].([]interface{}); len(options) != 0 {
+		t.Fatalf("expected the options to be cleared, found %v", options)
+	}
+	if members := d.Get("member").([]interface{}); len(members) != 0 {
+		t.Fatalf("expected no members in state, found %v", members)
+	}
+	if options := d.Get("option").(*schema.Set); options.Len() != 0 {
+		t.Fatalf("expected no options in state, found %v", options.List())
+	}
+}
+
+func TestUnitResourceIPv6NetworkCanonicalCIDR(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+
+	for _, test := range []struct {
+		name     string
+		resource *schema.Resource
+		cidr     string
+	}{
+		{"network", resourceIPv6Network(), "2001:DB8:0300:0000::/64"},
+		{"container", resourceIPv6Container(), "2001:0db8:0400:0:0:0:0:0/48"},
+	} {
+		config := map[string]interface{}{
+			"cidr": test.cidr,
+		}
+		if diags := test.resource.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{"cidr": "10.30.0.0/24"})); !diags.HasError() {
+			t.Fatalf("%s: expected an IPv4 cidr to be rejected", test.name)
+		}
+		if diags := test.resource.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{"parent_cidr": "10.30.0.0/16", "prefix_length": 24})); !diags.HasError() {
+			t.Fatalf("%s: expected an IPv4 parent_cidr to be rejected", test.name)
+		}
+
+		d := schema.TestResourceDataRaw(t, test.resource.Schema, config)
+		if diags := test.resource.CreateContext(context.Background(), d, client); diags.HasError() {
+			t.Fatalf("%s create: %+v", test.name, diags)
+		}
+
+		// The grid rewrites the cidr, which must not replace the resource
+		diff, err := test.resource.SimpleDiff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config), client)
+		if err != nil {
+			t.Fatalf("%s plan: %s", test.name, err)
+		}
+		if diff != nil && diff.RequiresNew() {
+			t.Fatalf("%s: expected no replacement for a non-canonical cidr, found %+v", test.name, diff.Attributes["cidr"])
+		}
+	}
+}
+
+func testAccCheckInfobloxIPv6NetworkExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource: %s not set", resourceName)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckInfobloxIPv6NetworkCreate() string {
+	return `
+  resource "infoblox_ipv6_container" "new" {
+    cidr    = "2001:db8:100::/48"
+    comment = "test ipv6 container"
+  }
+  resource "infoblox_ipv6_container" "site" {
+    parent_cidr   = infoblox_ipv6_container.new.cidr
+    prefix_length = 56
+    comment       = "test ipv6 site"
+    extensible_attributes = {
+      Site = jsonencode({
+        value = "ipv6-unit-site",
+        type  = "STRING"
+      })
+    }
+  }
+  resource "infoblox_ipv6_network" "new" {
+    parent_cidr   = infoblox_ipv6_container.site.cidr
+    prefix_length = 64
+    comment       = "test ipv6 network"
+    option {
+      name  = "domain-search-list"
+      code  = 24
+      value = "example.com"
+    }
+    extensible_attributes = {
+      Location = jsonencode({
+        value = "CollegeStation",
+        type  = "STRING"
+      })
+    }
+  }
+  resource "infoblox_ipv6_network" "ea" {
+    ea_search = {
+      "*Site" = "ipv6-unit-site"
+    }
+    prefix_length = 64
+    depends_on    = [infoblox_ipv6_network.new]
+  }
+`
+}
+
+func testAccCheckInfobloxIPv6NetworkUpdate() string {
+	return `
+  resource "infoblox_ipv6_container" "new" {
+    cidr    = "2001:db8:100::/48"
+    comment = "test ipv6 container"
+  }
+  resource "infoblox_ipv6_container" "site" {
+    parent_cidr   = infoblox_ipv6_container.new.cidr
+    prefix_length = 56
+    comment       = "test ipv6 site update"
+    extensible_attributes = {
+      Site = jsonencode({
+        value = "ipv6-unit-site",
+        type  = "STRING"
+      })
+    }
+  }
+  resource "infoblox_ipv6_network" "new" {
+    parent_cidr   = infoblox_ipv6_container.site.cidr
+    prefix_length = 64
+    comment       = "test ipv6 network update"
+    extensible_attributes = {
+      Location = jsonencode({
+        value = "CollegeStation2",
+        type  = "STRING"
+      })
+    }
+  }
+  resource "infoblox_ipv6_network" "ea" {
+    ea_search = {
+      "*Site" = "ipv6-unit-site"
+    }
+    prefix_length = 64
+    depends_on    = [infoblox_ipv6_network.new]
+  }
+`
+}
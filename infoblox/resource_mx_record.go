@@ -0,0 +1,302 @@
+package infoblox
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+func resourceMXRecord() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMXRecordCreate,
+		ReadContext:   resourceMXRecordRead,
+		UpdateContext: resourceMXRecordUpdate,
+		DeleteContext: resourceMXRecordDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customdiff.Sequence(
+			makeEACustomDiff("extensible_attributes"),
+		),
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:             schema.TypeString,
+				Description:      "Comment for the record; maximum 256 characters.",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 256)),
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Description: "Determines if the record is disabled or not. False means that the record is enabled.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"dns_mail_exchanger": {
+				Type:        schema.TypeString,
+				Description: "The mail exchanger in punycode format.",
+				Computed:    true,
+			},
+			"dns_name": {
+				Type:        schema.TypeString,
+				Description: "The name for an MX record in punycode format.",
+				Computed:    true,
+			},
+			"extensible_attribute": extensibleAttributeBlockSchema(),
+			"extensible_attributes": {
+				Type:             schema.TypeMap,
+				Description:      "Extensible attributes of MX record (Values are JSON encoded).",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateEa,
+				DiffSuppressFunc: eaSuppressDiff,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"mail_exchanger": {
+				Type:        schema.TypeString,
+				Description: "Mail exchanger name in FQDN format.",
+				Required:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the domain the MX record serves in FQDN format.",
+				Required:    true,
+			},
+			"preference": {
+				Type:         schema.TypeInt,
+				Description:  "Preference value; lower values are preferred.",
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Description: "Reference id of MX record object.",
+				Computed:    true,
+			},
+			"ttl": {
+				Type:             schema.TypeInt,
+				Description:      "The Time To Live (TTL) value for the record. When unset the zone TTL is used.",
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Description: "The name of the DNS view in which the record resides.",
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Description: "The name of the zone in which the record resides.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func convertMXRecordToResourceData(client *infoblox.Client, d *schema.ResourceData, record *infoblox.MXRecord) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.Set("ref", record.Ref)
+	d.Set("name", record.Name)
+	d.Set("dns_name", record.DNSName)
+	d.Set("mail_exchanger", record.MailExchanger)
+	d.Set("dns_mail_exchanger", record.DNSMailExchanger)
+	d.Set("preference", record.Preference)
+	d.Set("comment", record.Comment)
+	d.Set("disable", record.Disable)
+	d.Set("view", record.View)
+	d.Set("zone", record.Zone)
+	if record.UseTTL != nil && *record.UseTTL && record.TTL != nil {
+		d.Set("ttl", *record.TTL)
+	} else {
+		d.Set("ttl", nil)
+	}
+
+	eas, err := client.ConvertEAsToJSONString(*record.ExtensibleAttributes)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	} else {
+		d.Set("extensible_attributes", eas)
+	}
+
+	return diags
+}
+
+func convertResourceDataToMXRecord(client *infoblox.Client, d *schema.ResourceData) (*infoblox.MXRecord, error) {
+	var record infoblox.MXRecord
+
+	record.Name = d.Get("name").(string)
+	record.MailExchanger = d.Get("mail_exchanger").(string)
+	record.Preference = newInt(d.Get("preference").(int))
+	record.Comment = d.Get("comment").(string)
+	record.Disable = newBool(d.Get("disable").(bool))
+	record.View = d.Get("view").(string)
+	if ttl, ok := configuredInt(d, "ttl"); ok {
+		record.TTL = newInt(ttl)
+		record.UseTTL = newBool(true)
+	}
+
+	eaMap := d.Get("extensible_attributes").(map[string]interface{})
+	if len(eaMap) > 0 {
+		eas, err := createExtensibleAttributesFromJSON(eaMap)
+		if err != nil {
+			return &record, err
+		}
+		record.ExtensibleAttributes = &eas
+	}
+
+	if client.OrchestratorEAs != nil && len(*client.OrchestratorEAs) > 0 {
+		if record.ExtensibleAttributes == nil {
+			record.ExtensibleAttributes = &infoblox.ExtensibleAttribute{}
+		}
+		for k, v := range *client.OrchestratorEAs {
+			(*record.ExtensibleAttributes)[k] = v
+		}
+	}
+
+	return &record, nil
+}
+
+func resourceMXRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	record, err := client.GetMXRecordByRef(ref, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] MX record %s not found, removing from state", ref)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	check := convertMXRecordToResourceData(client, d, &record)
+	if check.HasError() {
+		return check
+	}
+
+	d.SetId(record.Ref)
+
+	return diags
+}
+
+func resourceMXRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+
+	record, err := convertResourceDataToMXRecord(client, d)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	err = client.CreateMXRecord(record)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	d.SetId(record.Ref)
+	return resourceMXRecordRead(ctx, d, m)
+}
+
+func resourceMXRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*infoblox.Client)
+
+	var record infoblox.MXRecord
+
+	if d.HasChange("name") {
+		record.Name = d.Get("name").(string)
+	}
+	if d.HasChange("mail_exchanger") {
+		record.MailExchanger = d.Get("mail_exchanger").(string)
+	}
+	if d.HasChange("preference") {
+		record.Preference = newInt(d.Get("preference").(int))
+	}
+	if d.HasChange("comment") {
+		record.Comment = d.Get("comment").(string)
+	}
+	if d.HasChange("disable") {
+		record.Disable = newBool(d.Get("disable").(bool))
+	}
+	if d.HasChange("ttl") {
+		ttl, ok := configuredInt(d, "ttl")
+		if ok {
+			record.TTL = newInt(ttl)
+		}
+		record.UseTTL = newBool(ok)
+	}
+	if d.HasChange("extensible_attributes") {
+		old, new := d.GetChange("extensible_attributes")
+		oldKeys := Keys(old.(map[string]interface{}))
+		oldEAs, err := createExtensibleAttributesFromJSON(old.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		newKeys := Keys(new.(map[string]interface{}))
+		newEAs, err := createExtensibleAttributesFromJSON(new.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		removeEAs := sliceDiff(oldKeys, newKeys, false)
+		if len(removeEAs) > 0 {
+			record.ExtensibleAttributesRemove = &infoblox.ExtensibleAttribute{}
+			for _, v := range removeEAs {
+				(*record.ExtensibleAttributesRemove)[v] = oldEAs[v]
+			}
+		}
+		for k, v := range newEAs {
+			if !Contains(oldKeys, k) || (Contains(oldKeys, k) && v.Value != oldEAs[k].Value) {
+				if record.ExtensibleAttributesAdd == nil {
+					record.ExtensibleAttributesAdd = &infoblox.ExtensibleAttribute{}
+				}
+				(*record.ExtensibleAttributesAdd)[k] = v
+			}
+		}
+		if client.OrchestratorEAs != nil && len(*client.OrchestratorEAs) > 0 {
+			if record.ExtensibleAttributesAdd == nil {
+				record.ExtensibleAttributesAdd = &infoblox.ExtensibleAttribute{}
+			}
+			for k, v := range *client.OrchestratorEAs {
+				(*record.ExtensibleAttributesAdd)[k] = v
+			}
+		}
+	}
+	changedRecord, err := client.UpdateMXRecord(d.Id(), record)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	d.SetId(changedRecord.Ref)
+	return resourceMXRecordRead(ctx, d, m)
+}
+
+func resourceMXRecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	err := client.DeleteMXRecord(ref)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
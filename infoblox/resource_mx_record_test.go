@@ -0,0 +1,203 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var (
+	mxRecordDomainName = os.Getenv("INFOBLOX_DOMAIN")
+)
+
+func TestAccInfobloxMXRecordBasic(t *testing.T) {
+	testAccSteps(t, func(providerConfig string) []resource.TestStep {
+		return testInfobloxMXRecordSteps(providerConfig, mxRecordDomainName)
+	})
+}
+
+func TestUnitInfobloxMXRecordBasic(t *testing.T) {
+	testUnitSteps(t, func(providerConfig string) []resource.TestStep {
+		return testInfobloxMXRecordSteps(providerConfig, "example.com")
+	})
+}
+
+func testInfobloxMXRecordSteps(providerConfig string, domain string) []resource.TestStep {
+	name := fmt.Sprintf("infoblox-test-mx.%s", domain)
+	exchanger := fmt.Sprintf("mail.%s", domain)
+	return []resource.TestStep{
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxMXRecordCreate(name, exchanger)),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxMXRecordExists("infoblox_mx_record.new"),
+				resource.TestCheckResourceAttr("infoblox_mx_record.new", "name", name),
+				resource.TestCheckResourceAttr("infoblox_mx_record.new", "mail_exchanger", exchanger),
+				resource.TestCheckResourceAttr("infoblox_mx_record.new", "preference", "10"),
+				resource.TestCheckResourceAttr("infoblox_mx_record.new", "comment", "test mx record"),
+				resource.TestCheckResourceAttr("infoblox_mx_record.new", "ttl", "300"),
+				resource.TestCheckResourceAttr("infoblox_mx_record.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation\",\"type\":\"STRING\"}"),
+				resource.TestCheckResourceAttr("infoblox_mx_record.new", "extensible_attributes.Orchestrator", "{\"value\":\"Terraform\",\"type\":\"ENUM\"}"),
+				testAccCheckInfobloxMXRecordExists("data.infoblox_mx_record.name"),
+				resource.TestCheckResourceAttr("data.infoblox_mx_record.name", "preference", "10"),
+				resource.TestCheckResourceAttr("data.infoblox_mx_record.name", "ttl", "300"),
+			),
+		},
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxMXRecordUpdate(name, exchanger)),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxMXRecordExists("infoblox_mx_record.new"),
+				resource.TestCheckResourceAttr("infoblox_mx_record.new", "preference", "20"),
+				resource.TestCheckResourceAttr("infoblox_mx_record.new", "comment", "test mx record update"),
+				resource.TestCheckNoResourceAttr("infoblox_mx_record.new", "ttl"),
+				resource.TestCheckResourceAttr("infoblox_mx_record.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation2\",\"type\":\"STRING\"}"),
+			),
+		},
+	}
+}
+
+func TestUnitResourceMXRecordDataSearch(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	r := resourceMXRecord()
+
+	var refs []string
+	for i, exchanger := range []string{"mx1.example.com", "mx2.example.com"} {
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+			"name":           "example.com",
+			"mail_exchanger": exchanger,
+			"preference":     (i + 1) * 10,
+		})
+		if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+			t.Fatalf("create %s: %+v", exchanger, diags)
+		}
+		if d.Get("dns_mail_exchanger").(string) != exchanger {
+			t.Fatalf("expected dns_mail_exchanger %s, found %s", exchanger, d.Get("dns_mail_exchanger"))
+		}
+		refs = append(refs, d.Id())
+	}
+
+	data := dataSourceMXRecord()
+	dd := schema.TestResourceDataRaw(t, data.Schema, map[string]interface{}{
+		"name": "example.com",
+	})
+	diags := data.ReadContext(context.Background(), dd, client)
+	if !diags.HasError() || diags[0].Summary != "Multiple data results found" {
+		t.Fatalf("expected multiple results error, found %+v", diags)
+	}
+
+	dd = schema.TestResourceDataRaw(t, data.Schema, map[string]interface{}{
+		"name":           "example.com",
+		"mail_exchanger": "mx2.example.com",
+	})
+	if diags := data.ReadContext(context.Background(), dd, client); diags.HasError() {
+		t.Fatalf("read: %+v", diags)
+	}
+	if dd.Id() != refs[1] || dd.Get("preference").(int) != 20 {
+		t.Fatalf("expected %s with preference 20, found %s/%d", refs[1], dd.Id(), dd.Get("preference"))
+	}
+}
+
+func TestUnitResourceMXRecordTTL(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	r := resourceMXRecord()
+	config := map[string]interface{}{
+		"name":           "example.com",
+		"mail_exchanger": "mx1.example.com",
+		"preference":     10,
+	}
+
+	// A ttl of 0 is sent rather than treated as unset
+	config["ttl"] = 0
+	d := testUnitPlanCreate(t, r, config, client)
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	record, ok := fake.lookup(d.Id())
+	if !ok {
+		t.Fatalf("create: record %s not found in fake grid", d.Id())
+	}
+	if record["use_ttl"] != true || fmt.Sprint(record["ttl"]) != "0" {
+		t.Fatalf("expected ttl 0 to be sent with use_ttl, found %v/%v", record["ttl"], record["use_ttl"])
+	}
+
+	config["ttl"] = 300
+	d = testUnitPlanUpdate(t, r, d, config, client)
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	record, _ = fake.lookup(d.Id())
+	if record["use_ttl"] != true || fmt.Sprint(record["ttl"]) != "300" {
+		t.Fatalf("expected ttl 300 to be sent with use_ttl, found %v/%v", record["ttl"], record["use_ttl"])
+	}
+
+	delete(config, "ttl")
+	d = testUnitPlanUpdate(t, r, d, config, client)
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	record, _ = fake.lookup(d.Id())
+	if record["use_ttl"] != false {
+		t.Fatalf("expected removing ttl to clear use_ttl, found %v", record["use_ttl"])
+	}
+}
+
+func testAccCheckInfobloxMXRecordExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource: %s not set", resourceName)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckInfobloxMXRecordCreate(name string, exchanger string) string {
+	return fmt.Sprintf(`
+  resource "infoblox_mx_record" "new" {
+    name           = "%s"
+    mail_exchanger = "%s"
+    preference     = 10
+    comment        = "test mx record"
+    ttl            = 300
+    extensible_attributes = {
+      Location = jsonencode({
+        value = "CollegeStation",
+        type  = "STRING"
+      })
+    }
+  }
+  data "infoblox_mx_record" "name" {
+    name           = infoblox_mx_record.new.name
+    mail_exchanger = infoblox_mx_record.new.mail_exchanger
+  }
+`, name, exchanger)
+}
+
+func testAccCheckInfobloxMXRecordUpdate(name string, exchanger string) string {
+	return fmt.Sprintf(`
+  resource "infoblox_mx_record" "new" {
+    name           = "%s"
+    mail_exchanger = "%s"
+    preference     = 20
+    comment        = "test mx record update"
+    extensible_attributes = {
+      Location = jsonencode({
+        value = "CollegeStation2",
+        type  = "STRING"
+      })
+    }
+  }
+`, name, exchanger)
+}
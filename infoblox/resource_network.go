@@ -2,6 +2,7 @@ package infoblox
 
 import (
 	"context"
+	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -63,6 +64,7 @@ func resourceNetwork() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"extensible_attribute": extensibleAttributeBlockSchema(),
 			"extensible_attributes": {
 				Type:             schema.TypeMap,
 				Description:      "Extensible attributes of network (Values are JSON encoded).",
@@ -418,6 +420,11 @@ func resourceNetworkRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 	network, err := client.GetNetworkByRef(ref, nil)
 	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] network %s not found, removing from state", ref)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.FromErr(err)...)
 		return diags
 	}
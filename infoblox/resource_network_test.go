@@ -1,11 +1,13 @@
 package infoblox
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/techBeck03/go-ipmath"
 )
@@ -161,3 +163,153 @@ resource "infoblox_network" "new" {
 }
 `, gridMemberHostname, networkNetworkAddress, networkGatewayAddress, networkGatewayAddress)
 }
+
+func TestUnitInfobloxNetworkBasic(t *testing.T) {
+	fake := newFakeWAPI(t)
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testUnitPreCheck(t) },
+		ProviderFactories: testUnitProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: composeConfig(fake.providerConfig(testUnitOrchestratorEAs), testUnitInfobloxNetwork("test network", "CollegeStation")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInfobloxNetworkExists("infoblox_network.new"),
+					resource.TestCheckResourceAttr("infoblox_network.new", "cidr", "10.10.0.0/24"),
+					resource.TestCheckResourceAttr("infoblox_network.new", "network_view", "default"),
+					resource.TestCheckResourceAttr("infoblox_network.new", "comment", "test network"),
+					resource.TestCheckResourceAttr("infoblox_network.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation\",\"type\":\"STRING\"}"),
+					resource.TestCheckResourceAttr("infoblox_network.new", "extensible_attributes.Orchestrator", "{\"value\":\"Terraform\",\"type\":\"ENUM\"}"),
+					resource.TestCheckResourceAttr("infoblox_network.new", "gateway_ip", "10.10.0.1"),
+					resource.TestCheckResourceAttr("infoblox_network.new", "extensible_attributes.Gateway", "{\"value\":\"10.10.0.1\",\"type\":\"STRING\"}"),
+				),
+			},
+			{
+				Config: composeConfig(fake.providerConfig(testUnitOrchestratorEAs), testUnitInfobloxNetwork("test network update", "CollegeStation2")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInfobloxNetworkExists("infoblox_network.new"),
+					resource.TestCheckResourceAttr("infoblox_network.new", "comment", "test network update"),
+					resource.TestCheckResourceAttr("infoblox_network.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation2\",\"type\":\"STRING\"}"),
+					resource.TestCheckResourceAttr("infoblox_network.new", "extensible_attributes.Gateway", "{\"value\":\"10.10.0.1\",\"type\":\"STRING\"}"),
+				),
+			},
+			{
+				ResourceName:      "infoblox_network.new",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"ea_search",
+					"gateway_ea",
+					"gateway_ip",
+					"gateway_label",
+					"gateway_offset",
+					"gateway_ref",
+					"parent_cidr",
+				},
+			},
+		},
+	})
+}
+
+func TestUnitInfobloxNetworkFromContainer(t *testing.T) {
+	fake := newFakeWAPI(t)
+	fake.create("networkcontainer", map[string]interface{}{
+		"network": "10.20.0.0/16",
+		"extattrs": map[string]interface{}{
+			"Site": map[string]interface{}{"value": "CollegeStation"},
+		},
+	})
+	fake.create("network", map[string]interface{}{
+		"network": "10.20.0.0/24",
+	})
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testUnitPreCheck(t) },
+		ProviderFactories: testUnitProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: composeConfig(fake.providerConfig(), `
+resource "infoblox_network" "parent" {
+	parent_cidr   = "10.20.0.0/16"
+	prefix_length = 24
+}
+resource "infoblox_network" "ea" {
+	ea_search = {
+		"*Site" = "CollegeStation"
+	}
+	prefix_length = 25
+	depends_on    = [infoblox_network.parent]
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("infoblox_network.parent", "cidr", "10.20.1.0/24"),
+					resource.TestCheckResourceAttr("infoblox_network.ea", "cidr", "10.20.2.0/25"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitInfobloxNetworkDisappears(t *testing.T) {
+	fake := newFakeWAPI(t)
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testUnitPreCheck(t) },
+		ProviderFactories: testUnitProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: composeConfig(fake.providerConfig(), `
+resource "infoblox_network" "new" {
+	cidr = "10.30.0.0/24"
+}
+`),
+				Check:              testUnitCheckDisappears(fake, "infoblox_network.new"),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testUnitInfobloxNetwork(comment string, location string) string {
+	return fmt.Sprintf(`
+resource "infoblox_network" "new" {
+	cidr           = "10.10.0.0/24"
+	comment        = "%s"
+	gateway_offset = 1
+	gateway_ea     = "Gateway"
+	extensible_attributes = {
+	  Location = jsonencode({
+		value = "%s",
+		type  = "STRING"
+	  })
+	}
+}
+`, comment, location)
+}
+
+func TestUnitResourceNetworkCRUD(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	r := resourceNetwork()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"cidr":    "10.80.0.0/24",
+		"comment": "test network",
+		"extensible_attributes": map[string]interface{}{
+			"Location": "{\"value\":\"CollegeStation\",\"type\":\"STRING\"}",
+		},
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	if _, ok := fake.lookup(d.Id()); !ok {
+		t.Fatalf("create: network %s not found in fake grid", d.Id())
+	}
+	if got := d.Get("comment").(string); got != "test network" {
+		t.Fatalf("create: expected comment %q, got %q", "test network", got)
+	}
+
+	fake.remove(d.Id())
+	if diags := r.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read: %+v", diags)
+	}
+	if d.Id() != "" {
+		t.Fatalf("read: expected deleted network to be removed from state, found id %s", d.Id())
+	}
+}
@@ -0,0 +1,257 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+func resourceNetworkView() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkViewCreate,
+		ReadContext:   resourceNetworkViewRead,
+		UpdateContext: resourceNetworkViewUpdate,
+		DeleteContext: resourceNetworkViewDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNetworkViewImport,
+		},
+		CustomizeDiff: customdiff.Sequence(
+			makeEACustomDiff("extensible_attributes"),
+		),
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:             schema.TypeString,
+				Description:      "Comment for the network view; maximum 256 characters.",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 256)),
+			},
+			"extensible_attribute": extensibleAttributeBlockSchema(),
+			"extensible_attributes": {
+				Type:             schema.TypeMap,
+				Description:      "Extensible attributes of network view (Values are JSON encoded).",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateEa,
+				DiffSuppressFunc: eaSuppressDiff,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"is_default": {
+				Type:        schema.TypeBool,
+				Description: "Whether this is the default network view.",
+				Computed:    true,
+			},
+			"name": {
+				Type:             schema.TypeString,
+				Description:      "Name of the network view.",
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 64)),
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Description: "Reference id of network view object.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func convertNetworkViewToResourceData(client *infoblox.Client, d *schema.ResourceData, view *infoblox.NetworkView) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.Set("ref", view.Ref)
+	d.Set("name", view.Name)
+	d.Set("comment", view.Comment)
+	if view.IsDefault != nil {
+		d.Set("is_default", *view.IsDefault)
+	}
+
+	eas, err := client.ConvertEAsToJSONString(*view.ExtensibleAttributes)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	} else {
+		d.Set("extensible_attributes", eas)
+	}
+
+	return diags
+}
+
+func convertResourceDataToNetworkView(client *infoblox.Client, d *schema.ResourceData) (*infoblox.NetworkView, error) {
+	var view infoblox.NetworkView
+
+	view.Name = d.Get("name").(string)
+	view.Comment = d.Get("comment").(string)
+
+	eaMap := d.Get("extensible_attributes").(map[string]interface{})
+	if len(eaMap) > 0 {
+		eas, err := createExtensibleAttributesFromJSON(eaMap)
+		if err != nil {
+			return &view, err
+		}
+		view.ExtensibleAttributes = &eas
+	}
+
+	if client.OrchestratorEAs != nil && len(*client.OrchestratorEAs) > 0 {
+		if view.ExtensibleAttributes == nil {
+			view.ExtensibleAttributes = &infoblox.ExtensibleAttribute{}
+		}
+		for k, v := range *client.OrchestratorEAs {
+			(*view.ExtensibleAttributes)[k] = v
+		}
+	}
+
+	return &view, nil
+}
+
+// resourceNetworkViewImport accepts either a reference id or the name of the
+// network view
+func resourceNetworkViewImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := m.(*infoblox.Client)
+
+	if strings.HasPrefix(d.Id(), "networkview/") {
+		return []*schema.ResourceData{d}, nil
+	}
+	views, err := client.GetNetworkViewByQuery(map[string]string{
+		"name": d.Id(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(views) != 1 {
+		return nil, fmt.Errorf("Expected one network view named %s but found %d", d.Id(), len(views))
+	}
+	d.SetId(views[0].Ref)
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceNetworkViewRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	view, err := client.GetNetworkViewByRef(ref, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] network view %s not found, removing from state", ref)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	check := convertNetworkViewToResourceData(client, d, &view)
+	if check.HasError() {
+		return check
+	}
+
+	d.SetId(view.Ref)
+
+	return diags
+}
+
+func resourceNetworkViewCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+
+	view, err := convertResourceDataToNetworkView(client, d)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	err = client.CreateNetworkView(view)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(view.Ref)
+	return resourceNetworkViewRead(ctx, d, m)
+}
+
+func resourceNetworkViewUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*infoblox.Client)
+
+	var view infoblox.NetworkView
+
+	if d.HasChange("name") {
+		view.Name = d.Get("name").(string)
+	}
+	if d.HasChange("comment") {
+		view.Comment = d.Get("comment").(string)
+	}
+	if d.HasChange("extensible_attributes") {
+		old, new := d.GetChange("extensible_attributes")
+		oldKeys := Keys(old.(map[string]interface{}))
+		oldEAs, err := createExtensibleAttributesFromJSON(old.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		newKeys := Keys(new.(map[string]interface{}))
+		newEAs, err := createExtensibleAttributesFromJSON(new.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		removeEAs := sliceDiff(oldKeys, newKeys, false)
+		if len(removeEAs) > 0 {
+			view.ExtensibleAttributesRemove = &infoblox.ExtensibleAttribute{}
+			for _, v := range removeEAs {
+				(*view.ExtensibleAttributesRemove)[v] = oldEAs[v]
+			}
+		}
+		for k, v := range newEAs {
+			if !Contains(oldKeys, k) || (Contains(oldKeys, k) && v.Value != oldEAs[k].Value) {
+				if view.ExtensibleAttributesAdd == nil {
+					view.ExtensibleAttributesAdd = &infoblox.ExtensibleAttribute{}
+				}
+				(*view.ExtensibleAttributesAdd)[k] = v
+			}
+		}
+		if client.OrchestratorEAs != nil && len(*client.OrchestratorEAs) > 0 {
+			if view.ExtensibleAttributesAdd == nil {
+				view.ExtensibleAttributesAdd = &infoblox.ExtensibleAttribute{}
+			}
+			for k, v := range *client.OrchestratorEAs {
+				(*view.ExtensibleAttributesAdd)[k] = v
+			}
+		}
+	}
+	changedView, err := client.UpdateNetworkView(d.Id(), view)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	d.SetId(changedView.Ref)
+	return resourceNetworkViewRead(ctx, d, m)
+}
+
+func resourceNetworkViewDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	err := client.DeleteNetworkView(ref)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
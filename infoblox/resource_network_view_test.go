@@ -0,0 +1,152 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccInfobloxNetworkViewBasic(t *testing.T) {
+	testAccSteps(t, testInfobloxNetworkViewSteps)
+}
+
+func TestUnitInfobloxNetworkViewBasic(t *testing.T) {
+	testUnitSteps(t, testInfobloxNetworkViewSteps)
+}
+
+func testInfobloxNetworkViewSteps(providerConfig string) []resource.TestStep {
+	return []resource.TestStep{
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxNetworkViewCreate()),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxNetworkViewExists("infoblox_network_view.new"),
+				resource.TestCheckResourceAttr("infoblox_network_view.new", "name", "terraform-tenant"),
+				resource.TestCheckResourceAttr("infoblox_network_view.new", "comment", "test network view"),
+				resource.TestCheckResourceAttr("infoblox_network_view.new", "is_default", "false"),
+				resource.TestCheckResourceAttr("infoblox_network_view.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation\",\"type\":\"STRING\"}"),
+				resource.TestCheckResourceAttr("infoblox_network_view.new", "extensible_attributes.Orchestrator", "{\"value\":\"Terraform\",\"type\":\"ENUM\"}"),
+				resource.TestCheckResourceAttr("infoblox_container.new", "network_view", "terraform-tenant"),
+				testAccCheckInfobloxNetworkViewExists("data.infoblox_network_view.name"),
+				resource.TestCheckResourceAttr("data.infoblox_network_view.name", "comment", "test network view"),
+				resource.TestCheckResourceAttr("data.infoblox_network_view.name", "extensible_attributes.Location", "{\"value\":\"CollegeStation\",\"type\":\"STRING\"}"),
+			),
+		},
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxNetworkViewUpdate()),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxNetworkViewExists("infoblox_network_view.new"),
+				resource.TestCheckResourceAttr("infoblox_network_view.new", "comment", "test network view update"),
+				resource.TestCheckResourceAttr("infoblox_network_view.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation2\",\"type\":\"STRING\"}"),
+			),
+		},
+		{
+			ResourceName:      "infoblox_network_view.new",
+			ImportState:       true,
+			ImportStateId:     "terraform-tenant",
+			ImportStateVerify: true,
+		},
+	}
+}
+
+func TestUnitResourceNetworkViewImportByName(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	ref := fake.create("networkview", map[string]interface{}{
+		"name":    "tenant-a",
+		"comment": "tenant a",
+	})
+
+	r := resourceNetworkView()
+	d := r.TestResourceData()
+	d.SetId("tenant-a")
+	imported, err := r.Importer.StateContext(context.Background(), d, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imported[0].Id() != ref {
+		t.Fatalf("expected import by name to resolve %s, found %s", ref, imported[0].Id())
+	}
+	if diags := r.ReadContext(context.Background(), imported[0], client); diags.HasError() {
+		t.Fatalf("read: %+v", diags)
+	}
+	if got := imported[0].Get("comment").(string); got != "tenant a" {
+		t.Fatalf("expected comment %q, found %q", "tenant a", got)
+	}
+
+	d = r.TestResourceData()
+	d.SetId("missing")
+	if _, err := r.Importer.StateContext(context.Background(), d, client); err == nil {
+		t.Fatal("expected import of a missing network view to fail")
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name": "tenant-b",
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	if d.Get("is_default").(bool) {
+		t.Fatal("expected created network view not to be the default")
+	}
+}
+
+func testAccCheckInfobloxNetworkViewExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource: %s not set", resourceName)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckInfobloxNetworkViewCreate() string {
+	return `
+  resource "infoblox_network_view" "new" {
+    name    = "terraform-tenant"
+    comment = "test network view"
+    extensible_attributes = {
+      Location = jsonencode({
+        value = "CollegeStation",
+        type  = "STRING"
+      })
+    }
+  }
+  resource "infoblox_container" "new" {
+    cidr         = "10.220.0.0/16"
+    network_view = infoblox_network_view.new.name
+  }
+  data "infoblox_network_view" "name" {
+    name = infoblox_network_view.new.name
+  }
+`
+}
+
+func testAccCheckInfobloxNetworkViewUpdate() string {
+	return `
+  resource "infoblox_network_view" "new" {
+    name    = "terraform-tenant"
+    comment = "test network view update"
+    extensible_attributes = {
+      Location = jsonencode({
+        value = "CollegeStation2",
+        type  = "STRING"
+      })
+    }
+  }
+  resource "infoblox_container" "new" {
+    cidr         = "10.220.0.0/16"
+    network_view = infoblox_network_view.new.name
+  }
+`
+}
@@ -2,6 +2,7 @@ package infoblox
 
 import (
 	"context"
+	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -46,6 +47,7 @@ func resourcePtrRecord() *schema.Resource {
 				Description: "The domain name of the DNS PTR record in punycode format.",
 				Computed:    true,
 			},
+			"extensible_attribute": extensibleAttributeBlockSchema(),
 			"extensible_attributes": {
 				Type:             schema.TypeMap,
 				Description:      "Extensible attributes of ptr record (Values are JSON encoded).",
@@ -178,6 +180,11 @@ func resourcePtrRecordRead(ctx context.Context, d *schema.ResourceData, m interf
 
 	record, err := client.GetPtrRecordByRef(ref, nil)
 	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] PTR record %s not found, removing from state", ref)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.FromErr(err)...)
 		return diags
 	}
@@ -3,6 +3,7 @@ package infoblox
 import (
 	"context"
 	"fmt"
+	"log"
 	"net"
 	"strings"
 
@@ -75,6 +76,7 @@ func resourceRange() *schema.Resource {
 				ConflictsWith:    []string{"sequential_count"},
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPv4Address),
 			},
+			"extensible_attribute": extensibleAttributeBlockSchema(),
 			"extensible_attributes": {
 				Type:             schema.TypeMap,
 				Description:      "Extensible attributes of range object (Values are JSON encoded).",
@@ -329,6 +331,11 @@ func resourceRangeRead(ctx context.Context, d *schema.ResourceData, m interface{
 
 	addressRange, err := client.GetRangeByRef(ref, nil)
 	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] range %s not found, removing from state", ref)
+			d.SetId("")
+			return diags
+		}
 		diags = append(diags, diag.FromErr(err)...)
 		return diags
 	}
@@ -0,0 +1,203 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var (
+	srvRecordDomainName = os.Getenv("INFOBLOX_DOMAIN")
+)
+
+func TestAccInfobloxSRVRecordBasic(t *testing.T) {
+	testAccSteps(t, func(providerConfig string) []resource.TestStep {
+		return testInfobloxSRVRecordSteps(providerConfig, srvRecordDomainName)
+	})
+}
+
+func TestUnitInfobloxSRVRecordBasic(t *testing.T) {
+	testUnitSteps(t, func(providerConfig string) []resource.TestStep {
+		return testInfobloxSRVRecordSteps(providerConfig, "example.com")
+	})
+}
+
+func TestUnitResourceSRVRecordCRUD(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	r := resourceSRVRecord()
+	config := map[string]interface{}{
+		"name":     "_sip._tcp.example.com",
+		"target":   "sip.example.com",
+		"port":     5060,
+		"priority": 10,
+		"weight":   5,
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, config)
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	if _, ok := fake.lookup(d.Id()); !ok {
+		t.Fatalf("create: record %s not found in fake grid", d.Id())
+	}
+
+	config["weight"] = 20
+	d = testUnitPlanUpdate(t, r, d, config, client)
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	if object, _ := fake.lookup(d.Id()); fmt.Sprint(object["weight"]) != "20" {
+		t.Fatalf("update: expected weight to be updated, found %v", object["weight"])
+	}
+
+	if diags := r.DeleteContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("delete: %+v", diags)
+	}
+	if _, ok := fake.lookup(d.Id()); ok {
+		t.Fatal("delete: expected the record to be deleted")
+	}
+}
+
+func TestUnitResourceSRVRecordTTL(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	r := resourceSRVRecord()
+	config := map[string]interface{}{
+		"name":     "_sip._tcp.example.com",
+		"target":   "sip.example.com",
+		"port":     5060,
+		"priority": 10,
+		"weight":   10,
+	}
+
+	// A ttl of 0 is sent rather than treated as unset
+	config["ttl"] = 0
+	d := testUnitPlanCreate(t, r, config, client)
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	record, ok := fake.lookup(d.Id())
+	if !ok {
+		t.Fatalf("create: record %s not found in fake grid", d.Id())
+	}
+	if record["use_ttl"] != true || fmt.Sprint(record["ttl"]) != "0" {
+		t.Fatalf("expected ttl 0 to be sent with use_ttl, found %v/%v", record["ttl"], record["use_ttl"])
+	}
+
+	config["ttl"] = 300
+	d = testUnitPlanUpdate(t, r, d, config, client)
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	record, _ = fake.lookup(d.Id())
+	if record["use_ttl"] != true || fmt.Sprint(record["ttl"]) != "300" {
+		t.Fatalf("expected ttl 300 to be sent with use_ttl, found %v/%v", record["ttl"], record["use_ttl"])
+	}
+
+	delete(config, "ttl")
+	d = testUnitPlanUpdate(t, r, d, config, client)
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	record, _ = fake.lookup(d.Id())
+	if record["use_ttl"] != false {
+		t.Fatalf("expected removing ttl to clear use_ttl, found %v", record["use_ttl"])
+	}
+}
+
+func testInfobloxSRVRecordSteps(providerConfig string, domain string) []resource.TestStep {
+	name := fmt.Sprintf("_sip._tcp.%s", domain)
+	target := fmt.Sprintf("sip.%s", domain)
+	return []resource.TestStep{
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxSRVRecordCreate(name, target)),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxSRVRecordExists("infoblox_srv_record.new"),
+				resource.TestCheckResourceAttr("infoblox_srv_record.new", "name", name),
+				resource.TestCheckResourceAttr("infoblox_srv_record.new", "target", target),
+				resource.TestCheckResourceAttr("infoblox_srv_record.new", "dns_target", target),
+				resource.TestCheckResourceAttr("infoblox_srv_record.new", "port", "5060"),
+				resource.TestCheckResourceAttr("infoblox_srv_record.new", "priority", "10"),
+				resource.TestCheckResourceAttr("infoblox_srv_record.new", "weight", "50"),
+				resource.TestCheckResourceAttr("infoblox_srv_record.new", "comment", "test srv record"),
+				resource.TestCheckResourceAttr("infoblox_srv_record.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation\",\"type\":\"STRING\"}"),
+				resource.TestCheckResourceAttr("infoblox_srv_record.new", "extensible_attributes.Orchestrator", "{\"value\":\"Terraform\",\"type\":\"ENUM\"}"),
+				testAccCheckInfobloxSRVRecordExists("data.infoblox_srv_record.name"),
+				resource.TestCheckResourceAttr("data.infoblox_srv_record.name", "port", "5060"),
+			),
+		},
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxSRVRecordUpdate(name, target)),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxSRVRecordExists("infoblox_srv_record.new"),
+				resource.TestCheckResourceAttr("infoblox_srv_record.new", "port", "5061"),
+				resource.TestCheckResourceAttr("infoblox_srv_record.new", "weight", "0"),
+				resource.TestCheckResourceAttr("infoblox_srv_record.new", "comment", "test srv record update"),
+				resource.TestCheckResourceAttr("infoblox_srv_record.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation2\",\"type\":\"STRING\"}"),
+			),
+		},
+	}
+}
+
+func testAccCheckInfobloxSRVRecordExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource: %s not set", resourceName)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckInfobloxSRVRecordCreate(name string, target string) string {
+	return fmt.Sprintf(`
+  resource "infoblox_srv_record" "new" {
+    name     = "%s"
+    target   = "%s"
+    port     = 5060
+    priority = 10
+    weight   = 50
+    comment  = "test srv record"
+    extensible_attributes = {
+      Location = jsonencode({
+        value = "CollegeStation",
+        type  = "STRING"
+      })
+    }
+  }
+  data "infoblox_srv_record" "name" {
+    name   = infoblox_srv_record.new.name
+    target = infoblox_srv_record.new.target
+  }
+`, name, target)
+}
+
+func testAccCheckInfobloxSRVRecordUpdate(name string, target string) string {
+	return fmt.Sprintf(`
+  resource "infoblox_srv_record" "new" {
+    name     = "%s"
+    target   = "%s"
+    port     = 5061
+    priority = 10
+    weight   = 0
+    comment  = "test srv record update"
+    extensible_attributes = {
+      Location = jsonencode({
+        value = "CollegeStation2",
+        type  = "STRING"
+      })
+    }
+  }
+`, name, target)
+}
@@ -0,0 +1,367 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+func resourceTXTRecord() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTXTRecordCreate,
+		ReadContext:   resourceTXTRecordRead,
+		UpdateContext: resourceTXTRecordUpdate,
+		DeleteContext: resourceTXTRecordDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customdiff.Sequence(
+			makeEACustomDiff("extensible_attributes"),
+		),
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:             schema.TypeString,
+				Description:      "Comment for the record; maximum 256 characters.",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 256)),
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Description: "Determines if the record is disabled or not. False means that the record is enabled.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"dns_name": {
+				Type:        schema.TypeString,
+				Description: "The name for a TXT record in punycode format.",
+				Computed:    true,
+			},
+			"extensible_attribute": extensibleAttributeBlockSchema(),
+			"extensible_attributes": {
+				Type:             schema.TypeMap,
+				Description:      "Extensible attributes of TXT record (Values are JSON encoded).",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateEa,
+				DiffSuppressFunc: eaSuppressDiff,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name for the TXT record in FQDN format.",
+				Required:    true,
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Description: "Reference id of TXT record object.",
+				Computed:    true,
+			},
+			"text": {
+				Type:        schema.TypeString,
+				Description: "Text associated with the record. Values longer than 255 characters are split into multiple strings automatically.",
+				Required:    true,
+			},
+			"ttl": {
+				Type:             schema.TypeInt,
+				Description:      "The Time To Live (TTL) value for the record. When unset the zone TTL is used.",
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Description: "The name of the DNS view in which the record resides.",
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Description: "The name of the zone in which the record resides.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func convertTXTRecordToResourceData(client *infoblox.Client, d *schema.ResourceData, record *infoblox.TXTRecord) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.Set("ref", record.Ref)
+	d.Set("name", record.Name)
+	d.Set("dns_name", record.DNSName)
+	d.Set("text", readTXTRecordText(d.Get("text").(string), record.Text))
+	d.Set("comment", record.Comment)
+	d.Set("disable", record.Disable)
+	d.Set("view", record.View)
+	d.Set("zone", record.Zone)
+	if record.UseTTL != nil && *record.UseTTL && record.TTL != nil {
+		d.Set("ttl", *record.TTL)
+	} else {
+		d.Set("ttl", nil)
+	}
+
+	eas, err := client.ConvertEAsToJSONString(*record.ExtensibleAttributes)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	} else {
+		d.Set("extensible_attributes", eas)
+	}
+
+	return diags
+}
+
+func convertResourceDataToTXTRecord(client *infoblox.Client, d *schema.ResourceData) (*infoblox.TXTRecord, error) {
+	var record infoblox.TXTRecord
+
+	record.Name = d.Get("name").(string)
+	record.Text = splitTXTRecordText(d.Get("text").(string))
+	record.Comment = d.Get("comment").(string)
+	record.Disable = newBool(d.Get("disable").(bool))
+	record.View = d.Get("view").(string)
+	if ttl, ok := configuredInt(d, "ttl"); ok {
+		record.TTL = newInt(ttl)
+		record.UseTTL = newBool(true)
+	}
+
+	eaMap := d.Get("extensible_attributes").(map[string]interface{})
+	if len(eaMap) > 0 {
+		eas, err := createExtensibleAttributesFromJSON(eaMap)
+		if err != nil {
+			return &record, err
+		}
+		record.ExtensibleAttributes = &eas
+	}
+
+	if client.OrchestratorEAs != nil && len(*client.OrchestratorEAs) > 0 {
+		if record.ExtensibleAttributes == nil {
+			record.ExtensibleAttributes = &infoblox.ExtensibleAttribute{}
+		}
+		for k, v := range *client.OrchestratorEAs {
+			(*record.ExtensibleAttributes)[k] = v
+		}
+	}
+
+	return &record, nil
+}
+
+func resourceTXTRecordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	record, err := client.GetTXTRecordByRef(ref, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] TXT record %s not found, removing from state", ref)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	check := convertTXTRecordToResourceData(client, d, &record)
+	if check.HasError() {
+		return check
+	}
+
+	d.SetId(record.Ref)
+
+	return diags
+}
+
+func resourceTXTRecordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+
+	record, err := convertResourceDataToTXTRecord(client, d)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	err = client.CreateTXTRecord(record)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	d.SetId(record.Ref)
+	return resourceTXTRecordRead(ctx, d, m)
+}
+
+func resourceTXTRecordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*infoblox.Client)
+
+	var record infoblox.TXTRecord
+
+	if d.HasChange("name") {
+		record.Name = d.Get("name").(string)
+	}
+	if d.HasChange("text") {
+		record.Text = splitTXTRecordText(d.Get("text").(string))
+	}
+	if d.HasChange("comment") {
+		record.Comment = d.Get("comment").(string)
+	}
+	if d.HasChange("disable") {
+		record.Disable = newBool(d.Get("disable").(bool))
+	}
+	if d.HasChange("ttl") {
+		ttl, ok := configuredInt(d, "ttl")
+		if ok {
+			record.TTL = newInt(ttl)
+		}
+		record.UseTTL = newBool(ok)
+	}
+	if d.HasChange("extensible_attributes") {
+		old, new := d.GetChange("extensible_attributes")
+		oldKeys := Keys(old.(map[string]interface{}))
+		oldEAs, err := createExtensibleAttributesFromJSON(old.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		newKeys := Keys(new.(map[string]interface{}))
+		newEAs, err := createExtensibleAttributesFromJSON(new.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		removeEAs := sliceDiff(oldKeys, newKeys, false)
+		if len(removeEAs) > 0 {
+			record.ExtensibleAttributesRemove = &infoblox.ExtensibleAttribute{}
+			for _, v := range removeEAs {
+				(*record.ExtensibleAttributesRemove)[v] = oldEAs[v]
+			}
+		}
+		for k, v := range newEAs {
+			if !Contains(oldKeys, k) || (Contains(oldKeys, k) && v.Value != oldEAs[k].Value) {
+				if record.ExtensibleAttributesAdd == nil {
+					record.ExtensibleAttributesAdd = &infoblox.ExtensibleAttribute{}
+				}
+				(*record.ExtensibleAttributesAdd)[k] = v
+			}
+		}
+		if client.OrchestratorEAs != nil && len(*client.OrchestratorEAs) > 0 {
+			if record.ExtensibleAttributesAdd == nil {
+				record.ExtensibleAttributesAdd = &infoblox.ExtensibleAttribute{}
+			}
+			for k, v := range *client.OrchestratorEAs {
+				(*record.ExtensibleAttributesAdd)[k] = v
+			}
+		}
+	}
+	changedRecord, err := client.UpdateTXTRecord(d.Id(), record)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	d.SetId(changedRecord.Ref)
+	return resourceTXTRecordRead(ctx, d, m)
+}
+
+func resourceTXTRecordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	err := client.DeleteTXTRecord(ref)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// txtStringMaxLength is the maximum length of a single character-string within a TXT record
+const txtStringMaxLength = 255
+
+// splitTXTRecordText converts text longer than a single character-string into the
+// space separated list of quoted strings expected by the grid
+func splitTXTRecordText(text string) string {
+	if len(text) <= txtStringMaxLength {
+		return text
+	}
+	var chunks []string
+	for len(text) > 0 {
+		end := len(text)
+		if end > txtStringMaxLength {
+			end = txtStringMaxLength
+			// Avoid splitting a multi-byte character across strings
+			for end > 0 && !utf8.RuneStart(text[end]) {
+				end--
+			}
+		}
+		chunk := strings.ReplaceAll(text[:end], `\`, `\\`)
+		chunk = strings.ReplaceAll(chunk, `"`, `\"`)
+		chunks = append(chunks, fmt.Sprintf(`"%s"`, chunk))
+		text = text[end:]
+	}
+	return strings.Join(chunks, " ")
+}
+
+// joinTXTRecordText reassembles a list of quoted strings returned by the grid
+// into a single value.  The second return value is false if text is not a list
+// of quoted strings.
+func joinTXTRecordText(text string) (string, bool) {
+	var joined strings.Builder
+	i := 0
+	for i < len(text) {
+		if text[i] == ' ' || text[i] == '\t' {
+			i++
+			continue
+		}
+		if text[i] != '"' {
+			return text, false
+		}
+		i++
+		closed := false
+		for i < len(text) {
+			c := text[i]
+			if c == '\\' && i+1 < len(text) {
+				joined.WriteByte(text[i+1])
+				i += 2
+				continue
+			}
+			i++
+			if c == '"' {
+				closed = true
+				break
+			}
+			joined.WriteByte(c)
+		}
+		if !closed {
+			return text, false
+		}
+	}
+	if joined.Len() == 0 {
+		return text, false
+	}
+	return joined.String(), true
+}
+
+// readTXTRecordText returns the value to store in state for the text returned by
+// the grid.  Split values are joined again so long configured text does not diff.
+func readTXTRecordText(current string, text string) string {
+	if current == text {
+		return text
+	}
+	if joined, ok := joinTXTRecordText(text); ok {
+		return joined
+	}
+	return text
+}
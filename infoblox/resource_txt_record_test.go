@@ -0,0 +1,254 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var (
+	txtRecordDomainName = os.Getenv("INFOBLOX_DOMAIN")
+)
+
+func TestAccInfobloxTXTRecordBasic(t *testing.T) {
+	testAccSteps(t, func(providerConfig string) []resource.TestStep {
+		return testInfobloxTXTRecordSteps(providerConfig, txtRecordDomainName)
+	})
+}
+
+func TestUnitInfobloxTXTRecordBasic(t *testing.T) {
+	testUnitSteps(t, func(providerConfig string) []resource.TestStep {
+		return testInfobloxTXTRecordSteps(providerConfig, "example.com")
+	})
+}
+
+func testInfobloxTXTRecordSteps(providerConfig string, domain string) []resource.TestStep {
+	name := fmt.Sprintf("infoblox-test-txt.%s", domain)
+	dkim := testTXTRecordDKIMKey()
+	return []resource.TestStep{
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxTXTRecordCreate(name, dkim)),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxTXTRecordExists("infoblox_txt_record.new"),
+				resource.TestCheckResourceAttr("infoblox_txt_record.new", "name", name),
+				resource.TestCheckResourceAttr("infoblox_txt_record.new", "text", "v=spf1 -all"),
+				resource.TestCheckResourceAttr("infoblox_txt_record.new", "comment", "test txt record"),
+				resource.TestCheckResourceAttr("infoblox_txt_record.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation\",\"type\":\"STRING\"}"),
+				resource.TestCheckResourceAttr("infoblox_txt_record.new", "extensible_attributes.Orchestrator", "{\"value\":\"Terraform\",\"type\":\"ENUM\"}"),
+				testAccCheckInfobloxTXTRecordExists("infoblox_txt_record.dkim"),
+				resource.TestCheckResourceAttr("infoblox_txt_record.dkim", "text", dkim),
+				testAccCheckInfobloxTXTRecordExists("data.infoblox_txt_record.dkim"),
+				resource.TestCheckResourceAttr("data.infoblox_txt_record.dkim", "text", dkim),
+			),
+		},
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxTXTRecordUpdate(name, dkim)),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxTXTRecordExists("infoblox_txt_record.new"),
+				resource.TestCheckResourceAttr("infoblox_txt_record.new", "text", "v=spf1 mx -all"),
+				resource.TestCheckResourceAttr("infoblox_txt_record.new", "comment", "test txt record update"),
+				resource.TestCheckResourceAttr("infoblox_txt_record.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation2\",\"type\":\"STRING\"}"),
+			),
+		},
+	}
+}
+
+func TestUnitResourceTXTRecordLongText(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	r := resourceTXTRecord()
+	dkim := testTXTRecordDKIMKey()
+	config := map[string]interface{}{
+		"name": "selector1._domainkey.example.com",
+		"text": dkim,
+	}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, config)
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	record, ok := fake.lookup(d.Id())
+	if !ok {
+		t.Fatalf("create: record %s not found in fake grid", d.Id())
+	}
+	stored := fmt.Sprint(record["text"])
+	if !strings.HasPrefix(stored, `"`) || strings.Count(stored, `" "`) != 1 {
+		t.Fatalf("expected text to be sent as two quoted strings, found %s", stored)
+	}
+	if d.Get("text").(string) != dkim {
+		t.Fatalf("expected text to be joined after read, found %s", d.Get("text"))
+	}
+
+	// Importing a split record must also produce the joined value
+	imported := r.Data(&terraform.InstanceState{ID: d.Id()})
+	if diags := r.ReadContext(context.Background(), imported, client); diags.HasError() {
+		t.Fatalf("import: %+v", diags)
+	}
+	if imported.Get("text").(string) != dkim {
+		t.Fatalf("expected imported text to be joined, found %s", imported.Get("text"))
+	}
+
+	diff, err := r.SimpleDiff(context.Background(), imported.State(), terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil && len(diff.Attributes) > 0 {
+		t.Fatalf("expected no diff for long text, found %+v", diff.Attributes)
+	}
+
+	data := dataSourceTXTRecord()
+	dd := schema.TestResourceDataRaw(t, data.Schema, map[string]interface{}{
+		"name": "selector1._domainkey.example.com",
+		"text": dkim,
+	})
+	if diags := data.ReadContext(context.Background(), dd, client); diags.HasError() {
+		t.Fatalf("read: %+v", diags)
+	}
+	if dd.Id() != d.Id() || dd.Get("text").(string) != dkim {
+		t.Fatalf("expected data source to find %s with joined text, found %s", d.Id(), dd.Id())
+	}
+}
+
+func TestUnitResourceTXTRecordTTL(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	r := resourceTXTRecord()
+	config := map[string]interface{}{
+		"name": "example.com",
+		"text": "v=spf1 -all",
+	}
+
+	// A ttl of 0 is sent rather than treated as unset
+	config["ttl"] = 0
+	d := testUnitPlanCreate(t, r, config, client)
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	record, ok := fake.lookup(d.Id())
+	if !ok {
+		t.Fatalf("create: record %s not found in fake grid", d.Id())
+	}
+	if record["use_ttl"] != true || fmt.Sprint(record["ttl"]) != "0" {
+		t.Fatalf("expected ttl 0 to be sent with use_ttl, found %v/%v", record["ttl"], record["use_ttl"])
+	}
+
+	config["ttl"] = 300
+	d = testUnitPlanUpdate(t, r, d, config, client)
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	record, _ = fake.lookup(d.Id())
+	if record["use_ttl"] != true || fmt.Sprint(record["ttl"]) != "300" {
+		t.Fatalf("expected ttl 300 to be sent with use_ttl, found %v/%v", record["ttl"], record["use_ttl"])
+	}
+
+	delete(config, "ttl")
+	d = testUnitPlanUpdate(t, r, d, config, client)
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	record, _ = fake.lookup(d.Id())
+	if record["use_ttl"] != false {
+		t.Fatalf("expected removing ttl to clear use_ttl, found %v", record["use_ttl"])
+	}
+}
+
+func TestUnitTXTRecordSplitJoin(t *testing.T) {
+	for _, text := range []string{
+		"v=spf1 -all",
+		strings.Repeat("a", 255),
+		strings.Repeat("b", 600),
+		strings.Repeat("é", 200),
+		strings.Repeat(`quote " and \ slash `, 30),
+	} {
+		split := splitTXTRecordText(text)
+		if len(text) <= 255 {
+			if split != text {
+				t.Fatalf("expected short text to be unchanged, found %s", split)
+			}
+			continue
+		}
+		joined, ok := joinTXTRecordText(split)
+		if !ok || joined != text {
+			t.Fatalf("expected %q to round trip, found %q", text, joined)
+		}
+	}
+	if _, ok := joinTXTRecordText("v=spf1 -all"); ok {
+		t.Fatal("expected unquoted text not to be joined")
+	}
+	if _, ok := joinTXTRecordText(`"unterminated`); ok {
+		t.Fatal("expected unterminated text not to be joined")
+	}
+	if text := readTXTRecordText(`"quoted"`, `"quoted"`); text != `"quoted"` {
+		t.Fatalf("expected configured quoted text to be kept, found %s", text)
+	}
+}
+
+func testTXTRecordDKIMKey() string {
+	return "v=DKIM1; k=rsa; p=" + strings.Repeat("MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEA", 8)
+}
+
+func testAccCheckInfobloxTXTRecordExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource: %s not set", resourceName)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckInfobloxTXTRecordCreate(name string, dkim string) string {
+	return fmt.Sprintf(`
+  resource "infoblox_txt_record" "new" {
+    name    = "%[1]s"
+    text    = "v=spf1 -all"
+    comment = "test txt record"
+    extensible_attributes = {
+      Location = jsonencode({
+        value = "CollegeStation",
+        type  = "STRING"
+      })
+    }
+  }
+  resource "infoblox_txt_record" "dkim" {
+    name = "selector1._domainkey.%[1]s"
+    text = "%[2]s"
+  }
+  data "infoblox_txt_record" "dkim" {
+    name = infoblox_txt_record.dkim.name
+  }
+`, name, dkim)
+}
+
+func testAccCheckInfobloxTXTRecordUpdate(name string, dkim string) string {
+	return fmt.Sprintf(`
+  resource "infoblox_txt_record" "new" {
+    name    = "%[1]s"
+    text    = "v=spf1 mx -all"
+    comment = "test txt record update"
+    extensible_attributes = {
+      Location = jsonencode({
+        value = "CollegeStation2",
+        type  = "STRING"
+      })
+    }
+  }
+  resource "infoblox_txt_record" "dkim" {
+    name = "selector1._domainkey.%[1]s"
+    text = "%[2]s"
+  }
+`, name, dkim)
+}
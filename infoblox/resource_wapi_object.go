@@ -0,0 +1,311 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+func resourceWAPIObject() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWAPIObjectCreate,
+		ReadContext:   resourceWAPIObjectRead,
+		UpdateContext: resourceWAPIObjectUpdate,
+		DeleteContext: resourceWAPIObjectDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"add_orchestrator_extensible_attributes": {
+				Type:        schema.TypeBool,
+				Description: "Add the orchestrator extensible attributes of the provider to the object. Disable for object types without extensible attributes.",
+				Optional:    true,
+				Default:     true,
+			},
+			"body": {
+				Type:             schema.TypeString,
+				Description:      "JSON encoded fields of the object. Only these fields are read back from the grid. Removing a field does not reset it on the grid.",
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsJSON),
+				DiffSuppressFunc: wapiObjectBodySuppressDiff,
+			},
+			"ignore_fields": {
+				Type:        schema.TypeList,
+				Description: "Fields of body that are only sent when the object is created and never compared with the grid.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"object_type": {
+				Type:             schema.TypeString,
+				Description:      "The WAPI object type to manage, e.g. zone_rp or dtc:pool.",
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringDoesNotContainAny("/?&")),
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Description: "Reference id of the object.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// decodeWAPIObjectBody decodes a JSON encoded object body, treating an empty
+// body as an object without fields
+func decodeWAPIObjectBody(body string) (map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+	if body == "" {
+		return fields, nil
+	}
+	err := json.Unmarshal([]byte(body), &fields)
+	return fields, err
+}
+
+// withoutFields returns a copy of body without the ignored fields
+func withoutFields(body map[string]interface{}, ignored []string) map[string]interface{} {
+	ret := make(map[string]interface{})
+	for k, v := range body {
+		if !Contains(ignored, k) {
+			ret[k] = v
+		}
+	}
+	return ret
+}
+
+// refreshWAPIObjectValue projects a value read from the grid onto the shape of
+// the configured value, so fields the grid adds to nested structs are not
+// reported as changes
+func refreshWAPIObjectValue(configured interface{}, current interface{}) interface{} {
+	switch c := configured.(type) {
+	case map[string]interface{}:
+		currentMap, ok := current.(map[string]interface{})
+		if !ok {
+			return current
+		}
+		ret := make(map[string]interface{})
+		for k, v := range c {
+			if currentValue, ok := currentMap[k]; ok {
+				ret[k] = refreshWAPIObjectValue(v, currentValue)
+			}
+		}
+		return ret
+	case []interface{}:
+		currentList, ok := current.([]interface{})
+		if !ok || len(currentList) != len(c) {
+			return current
+		}
+		ret := make([]interface{}, len(c))
+		for i := range c {
+			ret[i] = refreshWAPIObjectValue(c[i], currentList[i])
+		}
+		return ret
+	}
+	return current
+}
+
+// wapiObjectOrchestratorEAs returns the orchestrator EAs to add to the object,
+// or nil when there are none or the object opted out
+func wapiObjectOrchestratorEAs(client *infoblox.Client, d *schema.ResourceData) map[string]interface{} {
+	if client.OrchestratorEAs == nil || len(*client.OrchestratorEAs) == 0 || !d.Get("add_orchestrator_extensible_attributes").(bool) {
+		return nil
+	}
+	eas := make(map[string]interface{})
+	for k, v := range *client.OrchestratorEAs {
+		eas[k] = v
+	}
+	return eas
+}
+
+func wapiObjectIgnoreFields(d *schema.ResourceData) []string {
+	var ignored []string
+	for _, field := range d.Get("ignore_fields").([]interface{}) {
+		ignored = append(ignored, field.(string))
+	}
+	return ignored
+}
+
+func wapiObjectBodySuppressDiff(k, old, new string, d *schema.ResourceData) bool {
+	oldBody, err := decodeWAPIObjectBody(old)
+	if err != nil || old == "" {
+		return false
+	}
+	newBody, err := decodeWAPIObjectBody(new)
+	if err != nil {
+		return false
+	}
+	ignored := wapiObjectIgnoreFields(d)
+	return reflect.DeepEqual(withoutFields(oldBody, ignored), withoutFields(newBody, ignored))
+}
+
+func resourceWAPIObjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	body, err := decodeWAPIObjectBody(d.Get("body").(string))
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+	ignored := wapiObjectIgnoreFields(d)
+
+	fields := Keys(withoutFields(body, ignored))
+	sort.Strings(fields)
+
+	object, err := client.GetWAPIObjectByRef(ref, fields)
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] WAPI object %s not found, removing from state", ref)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	// Fields the grid does not return, such as write only fields, keep their
+	// configured value
+	for _, field := range fields {
+		if current, ok := object[field]; ok {
+			body[field] = refreshWAPIObjectValue(body[field], current)
+		}
+	}
+	refreshed, err := json.Marshal(body)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+	if equal, _ := areEqualJSON(d.Get("body").(string), string(refreshed)); !equal {
+		d.Set("body", string(refreshed))
+	}
+
+	d.Set("object_type", strings.SplitN(ref, "/", 2)[0])
+	d.Set("ref", object["_ref"])
+	d.SetId(fmt.Sprint(object["_ref"]))
+
+	return diags
+}
+
+func resourceWAPIObjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+
+	body, err := decodeWAPIObjectBody(d.Get("body").(string))
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	if orchestratorEAs := wapiObjectOrchestratorEAs(client, d); orchestratorEAs != nil {
+		eas, ok := body["extattrs"].(map[string]interface{})
+		if !ok {
+			eas = make(map[string]interface{})
+			body["extattrs"] = eas
+		}
+		for k, v := range orchestratorEAs {
+			eas[k] = v
+		}
+	}
+
+	ref, err := client.CreateWAPIObject(d.Get("object_type").(string), body)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	d.SetId(ref)
+
+	return resourceWAPIObjectRead(ctx, d, m)
+}
+
+func resourceWAPIObjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*infoblox.Client)
+
+	old, new := d.GetChange("body")
+	oldBody, err := decodeWAPIObjectBody(old.(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	newBody, err := decodeWAPIObjectBody(new.(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ignored := wapiObjectIgnoreFields(d)
+
+	// Fields removed from body are not sent, as most WAPI fields can not be
+	// unset with a null value
+	changes := make(map[string]interface{})
+	for k, v := range withoutFields(newBody, append(ignored, "extattrs")) {
+		if !reflect.DeepEqual(oldBody[k], v) {
+			changes[k] = v
+		}
+	}
+	addEAs := make(map[string]interface{})
+	if !Contains(ignored, "extattrs") && !reflect.DeepEqual(oldBody["extattrs"], newBody["extattrs"]) {
+		oldEAs, _ := oldBody["extattrs"].(map[string]interface{})
+		newEAs, _ := newBody["extattrs"].(map[string]interface{})
+		removeEAs := make(map[string]interface{})
+		for k, v := range oldEAs {
+			if _, ok := newEAs[k]; !ok {
+				removeEAs[k] = v
+			}
+		}
+		if len(removeEAs) > 0 {
+			changes["extattrs-"] = removeEAs
+		}
+		for k, v := range newEAs {
+			if !reflect.DeepEqual(oldEAs[k], v) {
+				addEAs[k] = v
+			}
+		}
+	}
+	// Orchestrator EAs are sent with every update, and when the object opts
+	// back in to them
+	if len(changes) > 0 || len(addEAs) > 0 || d.HasChange("add_orchestrator_extensible_attributes") {
+		for k, v := range wapiObjectOrchestratorEAs(client, d) {
+			addEAs[k] = v
+		}
+	}
+	if len(addEAs) > 0 {
+		changes["extattrs+"] = addEAs
+	}
+
+	if len(changes) > 0 {
+		ref, err := client.UpdateWAPIObject(d.Id(), changes)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+			return diags
+		}
+		d.SetId(ref)
+	}
+
+	return resourceWAPIObjectRead(ctx, d, m)
+}
+
+func resourceWAPIObjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	err := client.DeleteWAPIObject(ref)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
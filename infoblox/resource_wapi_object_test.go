@@ -0,0 +1,293 @@
+package infoblox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccInfobloxWAPIObjectBasic(t *testing.T) {
+	testAccSteps(t, testInfobloxWAPIObjectSteps)
+}
+
+func TestUnitInfobloxWAPIObjectBasic(t *testing.T) {
+	testUnitSteps(t, testInfobloxWAPIObjectSteps)
+}
+
+func testInfobloxWAPIObjectSteps(providerConfig string) []resource.TestStep {
+	return []resource.TestStep{
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxWAPIObjectCreate()),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxWAPIObjectExists("infoblox_wapi_object.rpz"),
+				resource.TestCheckResourceAttr("infoblox_wapi_object.rpz", "object_type", "zone_rp"),
+				resource.TestCheckResourceAttrWith("infoblox_wapi_object.rpz", "body", testAccCheckInfobloxWAPIObjectBodyField("comment", "test response policy zone")),
+			),
+		},
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxWAPIObjectUpdate()),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxWAPIObjectExists("infoblox_wapi_object.rpz"),
+				resource.TestCheckResourceAttrWith("infoblox_wapi_object.rpz", "body", testAccCheckInfobloxWAPIObjectBodyField("comment", "test response policy zone update")),
+			),
+		},
+	}
+}
+
+func TestUnitResourceWAPIObjectUpdate(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	eas, err := createExtensibleAttributesFromJSON(map[string]interface{}{
+		"Orchestrator": `{"value":"Terraform","type":"ENUM"}`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.OrchestratorEAs = &eas
+
+	r := resourceWAPIObject()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"object_type":   "zone_rp",
+		"body":          `{"fqdn":"rpz.example.com","view":"default","comment":"rpz","rpz_policy":"GIVEN","extattrs":{"Site":{"value":"site-a"},"Owner":{"value":"netops"}}}`,
+		"ignore_fields": []interface{}{"rpz_policy"},
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	object, ok := fake.lookup(d.Id())
+	if !ok {
+		t.Fatalf("create: object %s not found in fake grid", d.Id())
+	}
+	if _, ok := object["extattrs"].(map[string]interface{})["Orchestrator"]; !ok {
+		t.Fatalf("expected orchestrator EAs to be merged, found %v", object["extattrs"])
+	}
+	if strings.Contains(d.Get("body").(string), "Orchestrator") {
+		t.Fatalf("expected read to only refresh configured fields, found %s", d.Get("body"))
+	}
+
+	// The grid changes the ignored field and adds an EA outside of terraform
+	objectEAs := object["extattrs"].(map[string]interface{})
+	objectEAs["Gateway"] = map[string]interface{}{"value": "10.0.0.1"}
+	fake.modify(d.Id(), map[string]interface{}{
+		"rpz_policy": "NXDOMAIN",
+		"extattrs":   objectEAs,
+	})
+	if diags := r.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read: %+v", diags)
+	}
+
+	// Changing only an ignored field is not a change
+	state := d.State()
+	diff, err := r.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"object_type":   "zone_rp",
+		"body":          `{"fqdn":"rpz.example.com","view":"default","comment":"rpz","rpz_policy":"PASSTHRU","extattrs":{"Site":{"value":"site-a"},"Owner":{"value":"netops"}}}`,
+		"ignore_fields": []interface{}{"rpz_policy"},
+	}), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil && len(diff.Attributes) > 0 {
+		t.Fatalf("expected changes to ignored fields to be suppressed, found %v", diff.Attributes)
+	}
+
+	diff, err = r.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"object_type":   "zone_rp",
+		"body":          `{"fqdn":"rpz.example.com","view":"default","comment":"rpz update","rpz_policy":"GIVEN","extattrs":{"Site":{"value":"site-b"}}}`,
+		"ignore_fields": []interface{}{"rpz_policy"},
+	}), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff.RequiresNew() {
+		t.Fatal("expected body changes to update in place")
+	}
+	d, err = schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	object, _ = fake.lookup(d.Id())
+	objectEAs = object["extattrs"].(map[string]interface{})
+	if object["comment"] != "rpz update" || object["rpz_policy"] != "NXDOMAIN" {
+		t.Fatalf("expected only changed fields to be sent, found %v/%v", object["comment"], object["rpz_policy"])
+	}
+	if fmt.Sprint(objectEAs["Site"]) != "map[value:site-b]" || objectEAs["Owner"] != nil {
+		t.Fatalf("expected EAs to be added and removed, found %v", objectEAs)
+	}
+	if objectEAs["Gateway"] == nil || objectEAs["Orchestrator"] == nil {
+		t.Fatalf("expected unmanaged and orchestrator EAs to be kept, found %v", objectEAs)
+	}
+
+	if diags := r.DeleteContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("delete: %+v", diags)
+	}
+	if _, ok := fake.lookup(d.Id()); ok {
+		t.Fatal("expected the object to be deleted")
+	}
+	if diags := r.ReadContext(context.Background(), d, client); diags.HasError() || d.Id() != "" {
+		t.Fatalf("expected a deleted object to be removed from state, found %q %+v", d.Id(), diags)
+	}
+}
+
+func TestUnitResourceWAPIObjectOrchestratorEAs(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	eas, err := createExtensibleAttributesFromJSON(map[string]interface{}{
+		"Orchestrator": `{"value":"Terraform","type":"ENUM"}`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.OrchestratorEAs = &eas
+
+	// Orchestrator EAs are added to bodies without extattrs
+	r := resourceWAPIObject()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"object_type": "zone_rp",
+		"body":        `{"fqdn":"rpz.example.com","view":"default","comment":"rpz"}`,
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	object, _ := fake.lookup(d.Id())
+	if objectEAs, _ := object["extattrs"].(map[string]interface{}); objectEAs["Orchestrator"] == nil {
+		t.Fatalf("expected orchestrator EAs to be added, found %v", object["extattrs"])
+	}
+
+	// Orchestrator EAs removed outside of terraform are sent with the next update
+	fake.modify(d.Id(), map[string]interface{}{
+		"extattrs": map[string]interface{}{},
+	})
+	d = testUnitPlanUpdate(t, r, d, map[string]interface{}{
+		"object_type": "zone_rp",
+		"body":        `{"fqdn":"rpz.example.com","view":"default","comment":"rpz update"}`,
+	}, client)
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	object, _ = fake.lookup(d.Id())
+	if objectEAs, _ := object["extattrs"].(map[string]interface{}); objectEAs["Orchestrator"] == nil {
+		t.Fatalf("expected orchestrator EAs to be sent with the update, found %v", object["extattrs"])
+	}
+
+	// Objects can opt out of orchestrator EAs
+	optOut := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"object_type":                            "zone_rp",
+		"body":                                   `{"fqdn":"rpz2.example.com","view":"default"}`,
+		"add_orchestrator_extensible_attributes": false,
+	})
+	if diags := r.CreateContext(context.Background(), optOut, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	object, _ = fake.lookup(optOut.Id())
+	if objectEAs, _ := object["extattrs"].(map[string]interface{}); objectEAs["Orchestrator"] != nil {
+		t.Fatalf("expected orchestrator EAs to be skipped, found %v", object["extattrs"])
+	}
+}
+
+func TestUnitResourceWAPIObjectRemoveField(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+
+	r := resourceWAPIObject()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"object_type": "zone_rp",
+		"body":        `{"fqdn":"rpz.example.com","view":"default","comment":"rpz"}`,
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+
+	// Removing a field from body stops managing it without resetting it
+	d = testUnitPlanUpdate(t, r, d, map[string]interface{}{
+		"object_type": "zone_rp",
+		"body":        `{"fqdn":"rpz.example.com","view":"default"}`,
+	}, client)
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	if attempts := fake.attemptCount(http.MethodPut); attempts != 0 {
+		t.Fatalf("expected no update to be sent, found %d", attempts)
+	}
+	object, _ := fake.lookup(d.Id())
+	if object["comment"] != "rpz" {
+		t.Fatalf("expected the removed field to be kept on the grid, found %v", object["comment"])
+	}
+	if strings.Contains(d.Get("body").(string), "comment") {
+		t.Fatalf("expected the removed field to no longer be read, found %s", d.Get("body"))
+	}
+}
+
+func testAccCheckInfobloxWAPIObjectBodyField(field string, expected interface{}) resource.CheckResourceAttrWithFunc {
+	return func(value string) error {
+		var body map[string]interface{}
+		if err := json.Unmarshal([]byte(value), &body); err != nil {
+			return err
+		}
+		if body[field] != expected {
+			return fmt.Errorf("expected body field %s to be %v, found %v", field, expected, body[field])
+		}
+		return nil
+	}
+}
+
+func testAccCheckInfobloxWAPIObjectExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource: %s not set", resourceName)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckInfobloxWAPIObjectCreate() string {
+	return `
+  resource "infoblox_wapi_object" "rpz" {
+    object_type = "zone_rp"
+    body = jsonencode({
+      fqdn    = "rpz-wapi-object.example.com"
+      view    = "default"
+      comment = "test response policy zone"
+      extattrs = {
+        Location = {
+          value = "CollegeStation"
+        }
+      }
+    })
+  }
+`
+}
+
+func testAccCheckInfobloxWAPIObjectUpdate() string {
+	return `
+  resource "infoblox_wapi_object" "rpz" {
+    object_type = "zone_rp"
+    body = jsonencode({
+      fqdn    = "rpz-wapi-object.example.com"
+      view    = "default"
+      comment = "test response policy zone update"
+      extattrs = {
+        Location = {
+          value = "CollegeStation2"
+        }
+      }
+    })
+  }
+`
+}
@@ -0,0 +1,672 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+func resourceZoneAuth() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceZoneAuthCreate,
+		ReadContext:   resourceZoneAuthRead,
+		UpdateContext: resourceZoneAuthUpdate,
+		DeleteContext: resourceZoneAuthDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customdiff.Sequence(
+			zoneAuthCustomDiff,
+			makeEACustomDiff("extensible_attributes"),
+		),
+		Schema: map[string]*schema.Schema{
+			"allow_transfer": addressACSchema("Addresses allowed or denied zone transfers. When empty the grid or member setting is used."),
+			"allow_update":   addressACSchema("Addresses allowed or denied dynamic DNS updates. When empty the grid or member setting is used."),
+			"comment": {
+				Type:             schema.TypeString,
+				Description:      "Comment for the zone; maximum 256 characters.",
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 256)),
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Description: "Determines whether the zone is disabled.",
+				Optional:    true,
+				Default:     false,
+			},
+			"extensible_attribute": extensibleAttributeBlockSchema(),
+			"extensible_attributes": {
+				Type:             schema.TypeMap,
+				Description:      "Extensible attributes of the zone (Values are JSON encoded).",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateEa,
+				DiffSuppressFunc: eaSuppressDiff,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"fqdn": {
+				Type:        schema.TypeString,
+				Description: "The name of the zone. Reverse zones use IPv4 or IPv6 Address/CIDR format.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"grid_primary": {
+				Type:          schema.TypeList,
+				Description:   "Grid members that are primary servers for the zone.",
+				Optional:      true,
+				ConflictsWith: []string{"ns_group"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Hostname of the grid member.",
+							Required:    true,
+						},
+						"stealth": {
+							Type:        schema.TypeBool,
+							Description: "Hide the member's NS record from the zone.",
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+			"grid_ref": {
+				Type:         schema.TypeString,
+				Description:  "Ref for grid needed for restarting services.",
+				Optional:     true,
+				RequiredWith: []string{"restart_if_needed"},
+			},
+			"grid_secondaries": {
+				Type:          schema.TypeList,
+				Description:   "Grid members that are secondary servers for the zone.",
+				Optional:      true,
+				ConflictsWith: []string{"ns_group"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"grid_replicate": {
+							Type:        schema.TypeBool,
+							Description: "Use grid replication instead of zone transfers to update the member.",
+							Optional:    true,
+							Default:     false,
+						},
+						"lead": {
+							Type:        schema.TypeBool,
+							Description: "Send zone transfers from this member to the other secondaries.",
+							Optional:    true,
+							Default:     false,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Hostname of the grid member.",
+							Required:    true,
+						},
+						"stealth": {
+							Type:        schema.TypeBool,
+							Description: "Hide the member's NS record from the zone.",
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+			"ns_group": {
+				Type:          schema.TypeString,
+				Description:   "The name server group that serves the zone.",
+				Optional:      true,
+				ConflictsWith: []string{"grid_primary", "grid_secondaries"},
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Description: "Reference id of zone object.",
+				Computed:    true,
+			},
+			"restart_if_needed": {
+				Type:        schema.TypeBool,
+				Description: "Restart dns services on the zone's grid members if needed.",
+				Optional:    true,
+			},
+			"soa": {
+				Type:        schema.TypeList,
+				Description: "SOA settings overriding the grid or member values.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_ttl": {
+							Type:         schema.TypeInt,
+							Description:  "Default TTL in seconds for records in the zone.",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"email": {
+							Type:        schema.TypeString,
+							Description: "Administrator email address of the zone.",
+							Optional:    true,
+						},
+						"expire": {
+							Type:         schema.TypeInt,
+							Description:  "Seconds a secondary keeps serving the zone without reaching the primary.",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"negative_ttl": {
+							Type:         schema.TypeInt,
+							Description:  "Seconds negative responses for the zone are cached.",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"refresh": {
+							Type:         schema.TypeInt,
+							Description:  "Seconds between secondary checks of the primary serial number.",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"retry": {
+							Type:         schema.TypeInt,
+							Description:  "Seconds a secondary waits before retrying a failed refresh.",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Description: "The name of the DNS view in which the zone resides.",
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+			},
+			"zone_format": {
+				Type:             schema.TypeString,
+				Description:      "Format of the zone: FORWARD, IPV4 or IPV6.",
+				Optional:         true,
+				Default:          "FORWARD",
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validZoneFormats, false)),
+			},
+		},
+	}
+}
+
+func addressACSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address": {
+					Type:        schema.TypeString,
+					Description: "IP address, network in Address/CIDR format or Any.",
+					Required:    true,
+				},
+				"permission": {
+					Type:             schema.TypeString,
+					Description:      "ALLOW or DENY.",
+					Optional:         true,
+					Default:          "ALLOW",
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validAddressACPermissions, false)),
+				},
+			},
+		},
+	}
+}
+
+// zoneAuthCustomDiff checks reverse zones are named by the network they serve
+func zoneAuthCustomDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if !diff.NewValueKnown("fqdn") || !diff.NewValueKnown("zone_format") {
+		return nil
+	}
+	fqdn := diff.Get("fqdn").(string)
+	zoneFormat := diff.Get("zone_format").(string)
+	if zoneFormat == "FORWARD" {
+		return nil
+	}
+	ip, _, err := net.ParseCIDR(fqdn)
+	if err != nil {
+		return fmt.Errorf("fqdn must be in Address/CIDR format for %s zones", zoneFormat)
+	}
+	if (ip.To4() != nil) != (zoneFormat == "IPV4") {
+		return fmt.Errorf("fqdn %s does not match zone format %s", fqdn, zoneFormat)
+	}
+	return nil
+}
+
+func convertMemberServersToList(servers *[]infoblox.MemberServer, secondary bool) []map[string]interface{} {
+	var list []map[string]interface{}
+	if servers == nil {
+		return list
+	}
+	for _, server := range *servers {
+		item := map[string]interface{}{
+			"name":    server.Name,
+			"stealth": server.Stealth != nil && *server.Stealth,
+		}
+		if secondary {
+			item["grid_replicate"] = server.GridReplicate != nil && *server.GridReplicate
+			item["lead"] = server.Lead != nil && *server.Lead
+		}
+		list = append(list, item)
+	}
+	return list
+}
+
+func convertListToMemberServers(list []interface{}, secondary bool) []infoblox.MemberServer {
+	servers := []infoblox.MemberServer{}
+	for _, item := range list {
+		server := item.(map[string]interface{})
+		member := infoblox.MemberServer{
+			Name:    server["name"].(string),
+			Stealth: newBool(server["stealth"].(bool)),
+		}
+		if secondary {
+			member.GridReplicate = newBool(server["grid_replicate"].(bool))
+			member.Lead = newBool(server["lead"].(bool))
+		}
+		servers = append(servers, member)
+	}
+	return servers
+}
+
+func convertAddressACsToList(acs *[]infoblox.AddressAC) []map[string]interface{} {
+	var list []map[string]interface{}
+	if acs == nil {
+		return list
+	}
+	for _, ac := range *acs {
+		list = append(list, map[string]interface{}{
+			"address":    ac.Address,
+			"permission": ac.Permission,
+		})
+	}
+	return list
+}
+
+func convertListToAddressACs(list []interface{}) []infoblox.AddressAC {
+	acs := []infoblox.AddressAC{}
+	for _, item := range list {
+		ac := item.(map[string]interface{})
+		acs = append(acs, infoblox.AddressAC{
+			StructType: "addressac",
+			Address:    ac["address"].(string),
+			Permission: ac["permission"].(string),
+		})
+	}
+	return acs
+}
+
+// soaTimers are the timer arguments of the soa block
+var soaTimers = []string{"default_ttl", "expire", "negative_ttl", "refresh", "retry"}
+
+// configuredSOATimers returns the soa timers set in the configuration
+func configuredSOATimers(d rawConfigReader) []string {
+	var timers []string
+	config := d.GetRawConfig()
+	if !config.IsKnown() || config.IsNull() {
+		return timers
+	}
+	soa := config.GetAttr("soa")
+	if !soa.IsKnown() || soa.IsNull() || soa.LengthInt() == 0 {
+		return timers
+	}
+	block := soa.Index(cty.NumberIntVal(0))
+	for _, key := range soaTimers {
+		if !block.GetAttr(key).IsNull() {
+			timers = append(timers, key)
+		}
+	}
+	return timers
+}
+
+// setZoneAuthSOA sets the SOA overrides. Only the configured timers are sent,
+// the others keep the grid or member values
+func setZoneAuthSOA(zone *infoblox.ZoneAuth, d *schema.ResourceData) {
+	list := d.Get("soa").([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		zone.UseGridZoneTimer = newBool(false)
+		zone.UseSOAEmail = newBool(false)
+		return
+	}
+	soa := list[0].(map[string]interface{})
+	timers := map[string]**int{
+		"default_ttl":  &zone.SOADefaultTTL,
+		"expire":       &zone.SOAExpire,
+		"negative_ttl": &zone.SOANegativeTTL,
+		"refresh":      &zone.SOARefresh,
+		"retry":        &zone.SOARetry,
+	}
+	configured := configuredSOATimers(d)
+	for _, key := range configured {
+		*timers[key] = newInt(soa[key].(int))
+	}
+	zone.UseGridZoneTimer = newBool(len(configured) > 0)
+	zone.SOAEmail = soa["email"].(string)
+	zone.UseSOAEmail = newBool(zone.SOAEmail != "")
+}
+
+// zoneAuthMembers returns the grid member names serving the zone
+func zoneAuthMembers(primaries []interface{}, secondaries []interface{}) []string {
+	var members []string
+	for _, server := range append(append([]interface{}{}, primaries...), secondaries...) {
+		name := server.(map[string]interface{})["name"].(string)
+		if !Contains(members, name) {
+			members = append(members, name)
+		}
+	}
+	return members
+}
+
+func restartZoneAuthMembers(client *infoblox.Client, d *schema.ResourceData, members []string) error {
+	if !d.Get("restart_if_needed").(bool) || len(members) == 0 {
+		return nil
+	}
+	return client.RestartServices(d.Get("grid_ref").(string), infoblox.GridServiceRestartRequest{
+		RestartOption: "RESTART_IF_NEEDED",
+		Services:      []string{"DNS"},
+		Members:       members,
+	})
+}
+
+func convertZoneAuthToResourceData(client *infoblox.Client, d *schema.ResourceData, zone *infoblox.ZoneAuth) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.Set("ref", zone.Ref)
+	d.Set("fqdn", zone.FQDN)
+	d.Set("view", zone.View)
+	d.Set("zone_format", zone.ZoneFormat)
+	d.Set("comment", stringValue(zone.Comment))
+	d.Set("disable", zone.Disable != nil && *zone.Disable)
+	d.Set("ns_group", stringValue(zone.NSGroup))
+	d.Set("grid_primary", convertMemberServersToList(zone.GridPrimary, false))
+	d.Set("grid_secondaries", convertMemberServersToList(zone.GridSecondaries, true))
+
+	if zone.UseAllowTransfer != nil && *zone.UseAllowTransfer {
+		d.Set("allow_transfer", convertAddressACsToList(zone.AllowTransfer))
+	} else {
+		d.Set("allow_transfer", nil)
+	}
+	if zone.UseAllowUpdate != nil && *zone.UseAllowUpdate {
+		d.Set("allow_update", convertAddressACsToList(zone.AllowUpdate))
+	} else {
+		d.Set("allow_update", nil)
+	}
+
+	useTimers := zone.UseGridZoneTimer != nil && *zone.UseGridZoneTimer
+	useEmail := zone.UseSOAEmail != nil && *zone.UseSOAEmail
+	if useTimers || useEmail {
+		// Timers the zone does not override report the effective grid values
+		soa := map[string]interface{}{}
+		for key, value := range map[string]*int{
+			"default_ttl":  zone.SOADefaultTTL,
+			"expire":       zone.SOAExpire,
+			"negative_ttl": zone.SOANegativeTTL,
+			"refresh":      zone.SOARefresh,
+			"retry":        zone.SOARetry,
+		} {
+			if value != nil {
+				soa[key] = *value
+			}
+		}
+		if useEmail {
+			soa["email"] = zone.SOAEmail
+		}
+		d.Set("soa", []map[string]interface{}{soa})
+	} else {
+		d.Set("soa", nil)
+	}
+
+	eas, err := client.ConvertEAsToJSONString(*zone.ExtensibleAttributes)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	} else {
+		d.Set("extensible_attributes", eas)
+	}
+
+	return diags
+}
+
+func convertResourceDataToZoneAuth(client *infoblox.Client, d *schema.ResourceData) (*infoblox.ZoneAuth, error) {
+	var zone infoblox.ZoneAuth
+
+	zone.FQDN = d.Get("fqdn").(string)
+	zone.View = d.Get("view").(string)
+	zone.ZoneFormat = d.Get("zone_format").(string)
+	if comment := d.Get("comment").(string); comment != "" {
+		zone.Comment = newString(comment)
+	}
+	zone.Disable = newBool(d.Get("disable").(bool))
+	if nsGroup := d.Get("ns_group").(string); nsGroup != "" {
+		zone.NSGroup = newString(nsGroup)
+	}
+
+	if primaries := d.Get("grid_primary").([]interface{}); len(primaries) > 0 {
+		servers := convertListToMemberServers(primaries, false)
+		zone.GridPrimary = &servers
+	}
+	if secondaries := d.Get("grid_secondaries").([]interface{}); len(secondaries) > 0 {
+		servers := convertListToMemberServers(secondaries, true)
+		zone.GridSecondaries = &servers
+	}
+	if transfers := d.Get("allow_transfer").([]interface{}); len(transfers) > 0 {
+		acs := convertListToAddressACs(transfers)
+		zone.AllowTransfer = &acs
+		zone.UseAllowTransfer = newBool(true)
+	}
+	if updates := d.Get("allow_update").([]interface{}); len(updates) > 0 {
+		acs := convertListToAddressACs(updates)
+		zone.AllowUpdate = &acs
+		zone.UseAllowUpdate = newBool(true)
+	}
+	if soa := d.Get("soa").([]interface{}); len(soa) > 0 {
+		setZoneAuthSOA(&zone, d)
+	}
+
+	eaMap := d.Get("extensible_attributes").(map[string]interface{})
+	if len(eaMap) > 0 {
+		eas, err := createExtensibleAttributesFromJSON(eaMap)
+		if err != nil {
+			return &zone, err
+		}
+		zone.ExtensibleAttributes = &eas
+	}
+
+	if client.OrchestratorEAs != nil && len(*client.OrchestratorEAs) > 0 {
+		if zone.ExtensibleAttributes == nil {
+			zone.ExtensibleAttributes = &infoblox.ExtensibleAttribute{}
+		}
+		for k, v := range *client.OrchestratorEAs {
+			(*zone.ExtensibleAttributes)[k] = v
+		}
+	}
+
+	return &zone, nil
+}
+
+func resourceZoneAuthRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	zone, err := client.GetZoneAuthByRef(ref, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] zone %s not found, removing from state", ref)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	check := convertZoneAuthToResourceData(client, d, &zone)
+	if check.HasError() {
+		return check
+	}
+
+	d.SetId(zone.Ref)
+
+	return diags
+}
+
+func resourceZoneAuthCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+
+	zone, err := convertResourceDataToZoneAuth(client, d)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	err = client.CreateZoneAuth(zone)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	d.SetId(zone.Ref)
+
+	err = restartZoneAuthMembers(client, d, zoneAuthMembers(d.Get("grid_primary").([]interface{}), d.Get("grid_secondaries").([]interface{})))
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	return resourceZoneAuthRead(ctx, d, m)
+}
+
+func resourceZoneAuthUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*infoblox.Client)
+
+	var zone infoblox.ZoneAuth
+
+	// Cleared values are sent as empty strings so the grid unsets them
+	if d.HasChange("comment") {
+		zone.Comment = newString(d.Get("comment").(string))
+	}
+	if d.HasChange("disable") {
+		zone.Disable = newBool(d.Get("disable").(bool))
+	}
+	if d.HasChange("ns_group") {
+		zone.NSGroup = newString(d.Get("ns_group").(string))
+	}
+	if d.HasChange("grid_primary") {
+		servers := convertListToMemberServers(d.Get("grid_primary").([]interface{}), false)
+		zone.GridPrimary = &servers
+	}
+	if d.HasChange("grid_secondaries") {
+		servers := convertListToMemberServers(d.Get("grid_secondaries").([]interface{}), true)
+		zone.GridSecondaries = &servers
+	}
+	if d.HasChange("allow_transfer") {
+		acs := convertListToAddressACs(d.Get("allow_transfer").([]interface{}))
+		zone.AllowTransfer = &acs
+		zone.UseAllowTransfer = newBool(len(acs) > 0)
+	}
+	if d.HasChange("allow_update") {
+		acs := convertListToAddressACs(d.Get("allow_update").([]interface{}))
+		zone.AllowUpdate = &acs
+		zone.UseAllowUpdate = newBool(len(acs) > 0)
+	}
+	if d.HasChange("soa") {
+		setZoneAuthSOA(&zone, d)
+	}
+	if d.HasChange("extensible_attributes") {
+		old, new := d.GetChange("extensible_attributes")
+		oldKeys := Keys(old.(map[string]interface{}))
+		oldEAs, err := createExtensibleAttributesFromJSON(old.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		newKeys := Keys(new.(map[string]interface{}))
+		newEAs, err := createExtensibleAttributesFromJSON(new.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		removeEAs := sliceDiff(oldKeys, newKeys, false)
+		if len(removeEAs) > 0 {
+			zone.ExtensibleAttributesRemove = &infoblox.ExtensibleAttribute{}
+			for _, v := range removeEAs {
+				(*zone.ExtensibleAttributesRemove)[v] = oldEAs[v]
+			}
+		}
+		for k, v := range newEAs {
+			if !Contains(oldKeys, k) || (Contains(oldKeys, k) && v.Value != oldEAs[k].Value) {
+				if zone.ExtensibleAttributesAdd == nil {
+					zone.ExtensibleAttributesAdd = &infoblox.ExtensibleAttribute{}
+				}
+				(*zone.ExtensibleAttributesAdd)[k] = v
+			}
+		}
+		if client.OrchestratorEAs != nil && len(*client.OrchestratorEAs) > 0 {
+			if zone.ExtensibleAttributesAdd == nil {
+				zone.ExtensibleAttributesAdd = &infoblox.ExtensibleAttribute{}
+			}
+			for k, v := range *client.OrchestratorEAs {
+				(*zone.ExtensibleAttributesAdd)[k] = v
+			}
+		}
+	}
+
+	changedZone, err := client.UpdateZoneAuth(d.Id(), zone)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	// Members removed from the zone also need to reload their configuration
+	oldPrimaries, newPrimaries := d.GetChange("grid_primary")
+	oldSecondaries, newSecondaries := d.GetChange("grid_secondaries")
+	members := zoneAuthMembers(newPrimaries.([]interface{}), newSecondaries.([]interface{}))
+	for _, member := range zoneAuthMembers(oldPrimaries.([]interface{}), oldSecondaries.([]interface{})) {
+		if !Contains(members, member) {
+			members = append(members, member)
+		}
+	}
+	err = restartZoneAuthMembers(client, d, members)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	d.SetId(changedZone.Ref)
+	return resourceZoneAuthRead(ctx, d, m)
+}
+
+func resourceZoneAuthDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	err := client.DeleteZoneAuth(ref)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = restartZoneAuthMembers(client, d, zoneAuthMembers(d.Get("grid_primary").([]interface{}), d.Get("grid_secondaries").([]interface{})))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,328 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccInfobloxZoneAuthBasic(t *testing.T) {
+	testAccSteps(t, testInfobloxZoneAuthSteps)
+}
+
+func TestUnitInfobloxZoneAuthBasic(t *testing.T) {
+	testUnitSteps(t, testInfobloxZoneAuthSteps)
+}
+
+func testInfobloxZoneAuthSteps(providerConfig string) []resource.TestStep {
+	return []resource.TestStep{
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxZoneAuthCreate()),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxZoneAuthExists("infoblox_zone_auth.new"),
+				resource.TestCheckResourceAttr("infoblox_zone_auth.new", "fqdn", "terraform-zone.example.com"),
+				resource.TestCheckResourceAttr("infoblox_zone_auth.new", "view", "default"),
+				resource.TestCheckResourceAttr("infoblox_zone_auth.new", "zone_format", "FORWARD"),
+				resource.TestCheckResourceAttr("infoblox_zone_auth.new", "comment", "test zone"),
+				resource.TestCheckResourceAttr("infoblox_zone_auth.new", "soa.0.default_ttl", "3600"),
+				resource.TestCheckResourceAttr("infoblox_zone_auth.new", "soa.0.email", "hostmaster@example.com"),
+				resource.TestCheckResourceAttr("infoblox_zone_auth.new", "allow_transfer.#", "1"),
+				resource.TestCheckResourceAttr("infoblox_zone_auth.new", "allow_transfer.0.address", "10.0.0.0/8"),
+				resource.TestCheckResourceAttr("infoblox_zone_auth.new", "allow_transfer.0.permission", "ALLOW"),
+				resource.TestCheckResourceAttr("infoblox_zone_auth.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation\",\"type\":\"STRING\"}"),
+				resource.TestCheckResourceAttr("infoblox_zone_auth.new", "extensible_attributes.Orchestrator", "{\"value\":\"Terraform\",\"type\":\"ENUM\"}"),
+				testAccCheckInfobloxZoneAuthExists("infoblox_zone_auth.reverse"),
+				resource.TestCheckResourceAttr("infoblox_zone_auth.reverse", "zone_format", "IPV4"),
+			),
+		},
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxZoneAuthUpdate()),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxZoneAuthExists("infoblox_zone_auth.new"),
+				resource.TestCheckResourceAttr("infoblox_zone_auth.new", "comment", "test zone update"),
+				resource.TestCheckResourceAttr("infoblox_zone_auth.new", "soa.#", "0"),
+				resource.TestCheckResourceAttr("infoblox_zone_auth.new", "allow_transfer.#", "0"),
+				resource.TestCheckResourceAttr("infoblox_zone_auth.new", "allow_update.#", "1"),
+				resource.TestCheckResourceAttr("infoblox_zone_auth.new", "allow_update.0.permission", "DENY"),
+				resource.TestCheckResourceAttr("infoblox_zone_auth.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation2\",\"type\":\"STRING\"}"),
+			),
+		},
+		{
+			ResourceName:      "infoblox_zone_auth.new",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+}
+
+func TestUnitResourceZoneAuthRestart(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	gridRef := fake.refs("grid")[0]
+	r := resourceZoneAuth()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"fqdn":              "unit-zone.example.com",
+		"grid_ref":          gridRef,
+		"restart_if_needed": true,
+		"grid_primary": []interface{}{
+			map[string]interface{}{"name": "infoblox1.example.com"},
+		},
+		"grid_secondaries": []interface{}{
+			map[string]interface{}{"name": "infoblox2.example.com", "lead": true},
+		},
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	zone, ok := fake.lookup(d.Id())
+	if !ok {
+		t.Fatalf("create: zone %s not found in fake grid", d.Id())
+	}
+	secondaries := zone["grid_secondaries"].([]interface{})
+	if lead := secondaries[0].(map[string]interface{})["lead"]; lead != true {
+		t.Fatalf("expected secondary to be sent as lead, found %v", lead)
+	}
+	if d.Get("grid_secondaries.0.lead").(bool) != true {
+		t.Fatal("expected lead to be read back into state")
+	}
+
+	restarts := fake.restartRequests()
+	if len(restarts) != 1 {
+		t.Fatalf("expected 1 restart request, found %d", len(restarts))
+	}
+	if fmt.Sprint(restarts[0]["services"]) != "[DNS]" {
+		t.Fatalf("expected a DNS restart, found %v", restarts[0]["services"])
+	}
+	if fmt.Sprint(restarts[0]["members"]) != "[infoblox1.example.com infoblox2.example.com]" {
+		t.Fatalf("expected both zone members to be restarted, found %v", restarts[0]["members"])
+	}
+
+	if diags := r.DeleteContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("delete: %+v", diags)
+	}
+	if len(fake.refs("zone_auth")) != 0 {
+		t.Fatal("expected zone to be deleted")
+	}
+	if len(fake.restartRequests()) != 2 {
+		t.Fatal("expected delete to restart the zone members")
+	}
+
+	// Zones without grid members do not restart anything
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"fqdn":              "unit-zone.example.com",
+		"grid_ref":          gridRef,
+		"restart_if_needed": true,
+		"ns_group":          "default",
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	if len(fake.restartRequests()) != 2 {
+		t.Fatal("expected no restart for a zone served by a name server group")
+	}
+}
+
+func TestUnitResourceZoneAuthClear(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	r := resourceZoneAuth()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"fqdn":     "unit-clear.example.com",
+		"comment":  "served by a name server group",
+		"ns_group": "default",
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+
+	// Moving from the name server group to a grid primary clears ns_group and
+	// the removed comment on the grid
+	d = testUnitPlanUpdate(t, r, d, map[string]interface{}{
+		"fqdn": "unit-clear.example.com",
+		"grid_primary": []interface{}{
+			map[string]interface{}{"name": "infoblox1.example.com"},
+		},
+	}, client)
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	zone, ok := fake.lookup(d.Id())
+	if !ok {
+		t.Fatalf("update: zone %s not found in fake grid", d.Id())
+	}
+	for _, key := range []string{"comment", "ns_group"} {
+		if value, present := zone[key]; !present || value != "" {
+			t.Errorf("expected %s to be sent as an empty string, found %v", key, value)
+		}
+		if value := d.Get(key).(string); value != "" {
+			t.Errorf("expected %s to be unset in state, found %s", key, value)
+		}
+	}
+	if primaries, _ := zone["grid_primary"].([]interface{}); len(primaries) != 1 {
+		t.Errorf("expected the grid primary to be set, found %v", zone["grid_primary"])
+	}
+}
+
+func TestUnitResourceZoneAuthSOA(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	r := resourceZoneAuth()
+
+	// An email alone keeps the grid timers
+	config := map[string]interface{}{
+		"fqdn": "unit-soa.example.com",
+		"soa": []interface{}{
+			map[string]interface{}{"email": "hostmaster@example.com"},
+		},
+	}
+	d := testUnitPlanCreate(t, r, config, client)
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	zone, _ := fake.lookup(d.Id())
+	if zone["use_grid_zone_timer"] != false || zone["use_soa_email"] != true {
+		t.Fatalf("create: expected only the email to be overridden, found %v", zone)
+	}
+	if ttl := d.Get("soa.0.default_ttl").(int); ttl != 28800 {
+		t.Fatalf("create: expected the grid default_ttl to be read, found %d", ttl)
+	}
+	diff, err := r.SimpleDiff(context.Background(), testUnitRawConfigState(t, r, d.State(), config), terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil && len(diff.Attributes) > 0 {
+		t.Fatalf("expected no changes for the grid timers, found %v", diff.Attributes)
+	}
+
+	// Only the configured timers are overridden
+	config = map[string]interface{}{
+		"fqdn": "unit-soa.example.com",
+		"soa": []interface{}{
+			map[string]interface{}{"email": "hostmaster@example.com", "refresh": 600},
+		},
+	}
+	d = testUnitPlanUpdate(t, r, d, config, client)
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	zone, _ = fake.lookup(d.Id())
+	if zone["use_grid_zone_timer"] != true || zone["soa_refresh"] != float64(600) {
+		t.Fatalf("update: expected refresh to be overridden, found %v", zone)
+	}
+	if refresh, ttl := d.Get("soa.0.refresh").(int), d.Get("soa.0.default_ttl").(int); refresh != 600 || ttl != 28800 {
+		t.Fatalf("update: expected refresh 600 and the grid default_ttl, found %d and %d", refresh, ttl)
+	}
+
+	// Changing the email leaves the other timers alone
+	fake.modify(d.Id(), map[string]interface{}{"soa_expire": 1209600})
+	d = testUnitPlanUpdate(t, r, d, map[string]interface{}{
+		"fqdn": "unit-soa.example.com",
+		"soa": []interface{}{
+			map[string]interface{}{"email": "dns@example.com", "refresh": 600},
+		},
+	}, client)
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	zone, _ = fake.lookup(d.Id())
+	if zone["soa_email"] != "dns@example.com" || zone["soa_expire"] != float64(1209600) {
+		t.Fatalf("update: expected only the email and refresh to be sent, found %v", zone)
+	}
+}
+
+func TestUnitResourceZoneAuthReverseFormat(t *testing.T) {
+	r := resourceZoneAuth()
+	for _, tc := range []struct {
+		fqdn   string
+		format string
+		valid  bool
+	}{
+		{"example.com", "FORWARD", true},
+		{"10.0.0.0/24", "IPV4", true},
+		{"2001:db8::/64", "IPV6", true},
+		{"example.com", "IPV4", false},
+		{"10.0.0.0/24", "IPV6", false},
+		{"2001:db8::/64", "IPV4", false},
+	} {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"fqdn":        tc.fqdn,
+			"zone_format": tc.format,
+		})
+		_, err := r.SimpleDiff(context.Background(), nil, config, newFakeWAPI(t).client())
+		if tc.valid && err != nil {
+			t.Errorf("%s %s: unexpected error %s", tc.format, tc.fqdn, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("%s %s: expected an error", tc.format, tc.fqdn)
+		}
+	}
+}
+
+func testAccCheckInfobloxZoneAuthExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource: %s not set", resourceName)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckInfobloxZoneAuthCreate() string {
+	return `
+  resource "infoblox_zone_auth" "new" {
+    fqdn    = "terraform-zone.example.com"
+    comment = "test zone"
+    soa {
+      default_ttl = 3600
+      email       = "hostmaster@example.com"
+    }
+    allow_transfer {
+      address = "10.0.0.0/8"
+    }
+    extensible_attributes = {
+      Location = <<EOT
+      {"value":"CollegeStation","type":"STRING"}
+      EOT
+    }
+  }
+  resource "infoblox_zone_auth" "reverse" {
+    fqdn        = "10.211.0.0/24"
+    zone_format = "IPV4"
+  }
+`
+}
+
+func testAccCheckInfobloxZoneAuthUpdate() string {
+	return `
+  resource "infoblox_zone_auth" "new" {
+    fqdn    = "terraform-zone.example.com"
+    comment = "test zone update"
+    allow_update {
+      address    = "any"
+      permission = "DENY"
+    }
+    extensible_attributes = {
+      Location = <<EOT
+      {"value":"CollegeStation2","type":"STRING"}
+      EOT
+    }
+  }
+  resource "infoblox_zone_auth" "reverse" {
+    fqdn        = "10.211.0.0/24"
+    zone_format = "IPV4"
+  }
+`
+}
@@ -0,0 +1,333 @@
+package infoblox
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+func resourceZoneDelegated() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceZoneDelegatedCreate,
+		ReadContext:   resourceZoneDelegatedRead,
+		UpdateContext: resourceZoneDelegatedUpdate,
+		DeleteContext: resourceZoneDelegatedDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customdiff.Sequence(
+			zoneAuthCustomDiff,
+			makeEACustomDiff("extensible_attributes"),
+		),
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:             schema.TypeString,
+				Description:      "Comment for the zone; maximum 256 characters.",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 256)),
+			},
+			"delegate_to": externalServerSchema("Name servers the zone is delegated to.", true),
+			"delegated_ttl": {
+				Type:             schema.TypeInt,
+				Description:      "TTL in seconds of the delegation NS and glue records. When unset the grid or member TTL is used.",
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Description: "Determines whether the zone is disabled.",
+				Optional:    true,
+				Default:     false,
+			},
+			"extensible_attribute": extensibleAttributeBlockSchema(),
+			"extensible_attributes": {
+				Type:             schema.TypeMap,
+				Description:      "Extensible attributes of the zone (Values are JSON encoded).",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateEa,
+				DiffSuppressFunc: eaSuppressDiff,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"fqdn": {
+				Type:        schema.TypeString,
+				Description: "The name of the zone. Reverse zones use IPv4 or IPv6 Address/CIDR format.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Description: "Reference id of zone object.",
+				Computed:    true,
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Description: "The name of the DNS view in which the zone resides.",
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+			},
+			"zone_format": {
+				Type:             schema.TypeString,
+				Description:      "Format of the zone: FORWARD, IPV4 or IPV6.",
+				Optional:         true,
+				Default:          "FORWARD",
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validZoneFormats, false)),
+			},
+		},
+	}
+}
+
+func externalServerSchema(description string, required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Required:    required,
+		Optional:    !required,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address": {
+					Type:             schema.TypeString,
+					Description:      "IP address of the name server.",
+					Required:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPAddress),
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Description: "Hostname of the name server in FQDN format.",
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+func convertExternalServersToList(servers *[]infoblox.ExternalServer) []map[string]interface{} {
+	var list []map[string]interface{}
+	if servers == nil {
+		return list
+	}
+	for _, server := range *servers {
+		list = append(list, map[string]interface{}{
+			"address": server.Address,
+			"name":    server.Name,
+		})
+	}
+	return list
+}
+
+func convertListToExternalServers(list []interface{}) []infoblox.ExternalServer {
+	servers := []infoblox.ExternalServer{}
+	for _, item := range list {
+		server := item.(map[string]interface{})
+		servers = append(servers, infoblox.ExternalServer{
+			Address: server["address"].(string),
+			Name:    server["name"].(string),
+		})
+	}
+	return servers
+}
+
+func convertZoneDelegatedToResourceData(client *infoblox.Client, d *schema.ResourceData, zone *infoblox.ZoneDelegated) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.Set("ref", zone.Ref)
+	d.Set("fqdn", zone.FQDN)
+	d.Set("view", zone.View)
+	d.Set("zone_format", zone.ZoneFormat)
+	d.Set("comment", zone.Comment)
+	d.Set("disable", zone.Disable != nil && *zone.Disable)
+	d.Set("delegate_to", convertExternalServersToList(zone.DelegateTo))
+	if zone.UseDelegatedTTL != nil && *zone.UseDelegatedTTL && zone.DelegatedTTL != nil {
+		d.Set("delegated_ttl", *zone.DelegatedTTL)
+	} else {
+		d.Set("delegated_ttl", nil)
+	}
+
+	eas, err := client.ConvertEAsToJSONString(*zone.ExtensibleAttributes)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	} else {
+		d.Set("extensible_attributes", eas)
+	}
+
+	return diags
+}
+
+func convertResourceDataToZoneDelegated(client *infoblox.Client, d *schema.ResourceData) (*infoblox.ZoneDelegated, error) {
+	var zone infoblox.ZoneDelegated
+
+	zone.FQDN = d.Get("fqdn").(string)
+	zone.View = d.Get("view").(string)
+	zone.ZoneFormat = d.Get("zone_format").(string)
+	zone.Comment = d.Get("comment").(string)
+	zone.Disable = newBool(d.Get("disable").(bool))
+
+	servers := convertListToExternalServers(d.Get("delegate_to").([]interface{}))
+	zone.DelegateTo = &servers
+
+	if ttl, ok := configuredInt(d, "delegated_ttl"); ok {
+		zone.DelegatedTTL = newInt(ttl)
+		zone.UseDelegatedTTL = newBool(true)
+	}
+
+	eaMap := d.Get("extensible_attributes").(map[string]interface{})
+	if len(eaMap) > 0 {
+		eas, err := createExtensibleAttributesFromJSON(eaMap)
+		if err != nil {
+			return &zone, err
+		}
+		zone.ExtensibleAttributes = &eas
+	}
+
+	if client.OrchestratorEAs != nil && len(*client.OrchestratorEAs) > 0 {
+		if zone.ExtensibleAttributes == nil {
+			zone.ExtensibleAttributes = &infoblox.ExtensibleAttribute{}
+		}
+		for k, v := range *client.OrchestratorEAs {
+			(*zone.ExtensibleAttributes)[k] = v
+		}
+	}
+
+	return &zone, nil
+}
+
+func resourceZoneDelegatedRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	zone, err := client.GetZoneDelegatedByRef(ref, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] delegated zone %s not found, removing from state", ref)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	check := convertZoneDelegatedToResourceData(client, d, &zone)
+	if check.HasError() {
+		return check
+	}
+
+	d.SetId(zone.Ref)
+
+	return diags
+}
+
+func resourceZoneDelegatedCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+
+	zone, err := convertResourceDataToZoneDelegated(client, d)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	err = client.CreateZoneDelegated(zone)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	d.SetId(zone.Ref)
+	return resourceZoneDelegatedRead(ctx, d, m)
+}
+
+func resourceZoneDelegatedUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*infoblox.Client)
+
+	var zone infoblox.ZoneDelegated
+
+	if d.HasChange("comment") {
+		zone.Comment = d.Get("comment").(string)
+	}
+	if d.HasChange("disable") {
+		zone.Disable = newBool(d.Get("disable").(bool))
+	}
+	if d.HasChange("delegate_to") {
+		servers := convertListToExternalServers(d.Get("delegate_to").([]interface{}))
+		zone.DelegateTo = &servers
+	}
+	if d.HasChange("delegated_ttl") {
+		ttl, ok := configuredInt(d, "delegated_ttl")
+		if ok {
+			zone.DelegatedTTL = newInt(ttl)
+		}
+		zone.UseDelegatedTTL = newBool(ok)
+	}
+	if d.HasChange("extensible_attributes") {
+		old, new := d.GetChange("extensible_attributes")
+		oldKeys := Keys(old.(map[string]interface{}))
+		oldEAs, err := createExtensibleAttributesFromJSON(old.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		newKeys := Keys(new.(map[string]interface{}))
+		newEAs, err := createExtensibleAttributesFromJSON(new.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		removeEAs := sliceDiff(oldKeys, newKeys, false)
+		if len(removeEAs) > 0 {
+			zone.ExtensibleAttributesRemove = &infoblox.ExtensibleAttribute{}
+			for _, v := range removeEAs {
+				(*zone.ExtensibleAttributesRemove)[v] = oldEAs[v]
+			}
+		}
+		for k, v := range newEAs {
+			if !Contains(oldKeys, k) || (Contains(oldKeys, k) && v.Value != oldEAs[k].Value) {
+				if zone.ExtensibleAttributesAdd == nil {
+					zone.ExtensibleAttributesAdd = &infoblox.ExtensibleAttribute{}
+				}
+				(*zone.ExtensibleAttributesAdd)[k] = v
+			}
+		}
+		if client.OrchestratorEAs != nil && len(*client.OrchestratorEAs) > 0 {
+			if zone.ExtensibleAttributesAdd == nil {
+				zone.ExtensibleAttributesAdd = &infoblox.ExtensibleAttribute{}
+			}
+			for k, v := range *client.OrchestratorEAs {
+				(*zone.ExtensibleAttributesAdd)[k] = v
+			}
+		}
+	}
+
+	changedZone, err := client.UpdateZoneDelegated(d.Id(), zone)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	d.SetId(changedZone.Ref)
+	return resourceZoneDelegatedRead(ctx, d, m)
+}
+
+func resourceZoneDelegatedDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	err := client.DeleteZoneDelegated(ref)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
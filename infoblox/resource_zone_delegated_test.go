@@ -0,0 +1,162 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccInfobloxZoneDelegatedBasic(t *testing.T) {
+	testAccSteps(t, testInfobloxZoneDelegatedSteps)
+}
+
+func TestUnitInfobloxZoneDelegatedBasic(t *testing.T) {
+	testUnitSteps(t, testInfobloxZoneDelegatedSteps)
+}
+
+func testInfobloxZoneDelegatedSteps(providerConfig string) []resource.TestStep {
+	return []resource.TestStep{
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxZoneDelegatedCreate()),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxZoneDelegatedExists("infoblox_zone_delegated.new"),
+				resource.TestCheckResourceAttr("infoblox_zone_delegated.new", "fqdn", "cloud.terraform-zone.example.com"),
+				resource.TestCheckResourceAttr("infoblox_zone_delegated.new", "view", "default"),
+				resource.TestCheckResourceAttr("infoblox_zone_delegated.new", "comment", "test delegated zone"),
+				resource.TestCheckResourceAttr("infoblox_zone_delegated.new", "delegate_to.#", "2"),
+				resource.TestCheckResourceAttr("infoblox_zone_delegated.new", "delegate_to.0.name", "ns-1.awsdns-01.com"),
+				resource.TestCheckResourceAttr("infoblox_zone_delegated.new", "delegate_to.0.address", "205.251.192.1"),
+				resource.TestCheckResourceAttr("infoblox_zone_delegated.new", "delegated_ttl", "3600"),
+				resource.TestCheckResourceAttr("infoblox_zone_delegated.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation\",\"type\":\"STRING\"}"),
+				resource.TestCheckResourceAttr("infoblox_zone_delegated.new", "extensible_attributes.Orchestrator", "{\"value\":\"Terraform\",\"type\":\"ENUM\"}"),
+			),
+		},
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxZoneDelegatedUpdate()),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxZoneDelegatedExists("infoblox_zone_delegated.new"),
+				resource.TestCheckResourceAttr("infoblox_zone_delegated.new", "comment", "test delegated zone update"),
+				resource.TestCheckResourceAttr("infoblox_zone_delegated.new", "delegate_to.#", "1"),
+				resource.TestCheckNoResourceAttr("infoblox_zone_delegated.new", "delegated_ttl"),
+				resource.TestCheckResourceAttr("infoblox_zone_delegated.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation2\",\"type\":\"STRING\"}"),
+			),
+		},
+		{
+			ResourceName:      "infoblox_zone_delegated.new",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+}
+
+func TestUnitResourceZoneDelegatedTTL(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	r := resourceZoneDelegated()
+
+	config := map[string]interface{}{
+		"fqdn": "unit-delegated.example.com",
+		"delegate_to": []interface{}{
+			map[string]interface{}{"name": "ns1.cloud.example.net", "address": "192.0.2.53"},
+		},
+	}
+
+	// A delegated_ttl of 0 is sent rather than treated as unset
+	config["delegated_ttl"] = 0
+	d := testUnitPlanCreate(t, r, config, client)
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	zone, ok := fake.lookup(d.Id())
+	if !ok {
+		t.Fatalf("create: zone %s not found in fake grid", d.Id())
+	}
+	if zone["use_delegated_ttl"] != true || fmt.Sprint(zone["delegated_ttl"]) != "0" {
+		t.Fatalf("expected delegated ttl 0 to be sent with use_delegated_ttl, found %v/%v", zone["delegated_ttl"], zone["use_delegated_ttl"])
+	}
+
+	config["delegated_ttl"] = 600
+	d = testUnitPlanUpdate(t, r, d, config, client)
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	zone, _ = fake.lookup(d.Id())
+	if zone["use_delegated_ttl"] != true || fmt.Sprint(zone["delegated_ttl"]) != "600" {
+		t.Fatalf("expected delegated ttl 600 to be sent with use_delegated_ttl, found %v/%v", zone["delegated_ttl"], zone["use_delegated_ttl"])
+	}
+
+	delete(config, "delegated_ttl")
+	d = testUnitPlanUpdate(t, r, d, config, client)
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("update: %+v", diags)
+	}
+	zone, _ = fake.lookup(d.Id())
+	if zone["use_delegated_ttl"] != false {
+		t.Fatalf("expected removing delegated_ttl to clear use_delegated_ttl, found %v", zone["use_delegated_ttl"])
+	}
+	if _, ok := d.GetOk("delegated_ttl"); ok {
+		t.Fatal("expected delegated_ttl to be unset once the inherited ttl is used")
+	}
+}
+
+func testAccCheckInfobloxZoneDelegatedExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource: %s not set", resourceName)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckInfobloxZoneDelegatedCreate() string {
+	return `
+  resource "infoblox_zone_delegated" "new" {
+    fqdn          = "cloud.terraform-zone.example.com"
+    comment       = "test delegated zone"
+    delegated_ttl = 3600
+    delegate_to {
+      name    = "ns-1.awsdns-01.com"
+      address = "205.251.192.1"
+    }
+    delegate_to {
+      name    = "ns-2.awsdns-02.net"
+      address = "205.251.196.2"
+    }
+    extensible_attributes = {
+      Location = jsonencode({
+        value = "CollegeStation",
+        type  = "STRING"
+      })
+    }
+  }
+`
+}
+
+func testAccCheckInfobloxZoneDelegatedUpdate() string {
+	return `
+  resource "infoblox_zone_delegated" "new" {
+    fqdn    = "cloud.terraform-zone.example.com"
+    comment = "test delegated zone update"
+    delegate_to {
+      name    = "ns-1.awsdns-01.com"
+      address = "205.251.192.1"
+    }
+    extensible_attributes = {
+      Location = jsonencode({
+        value = "CollegeStation2",
+        type  = "STRING"
+      })
+    }
+  }
+`
+}
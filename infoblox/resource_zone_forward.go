@@ -0,0 +1,387 @@
+package infoblox
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	infoblox "github.com/techBeck03/infoblox-go-sdk"
+)
+
+func resourceZoneForward() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceZoneForwardCreate,
+		ReadContext:   resourceZoneForwardRead,
+		UpdateContext: resourceZoneForwardUpdate,
+		DeleteContext: resourceZoneForwardDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customdiff.Sequence(
+			zoneAuthCustomDiff,
+			makeEACustomDiff("extensible_attributes"),
+		),
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:             schema.TypeString,
+				Description:      "Comment for the zone; maximum 256 characters.",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 256)),
+			},
+			"disable": {
+				Type:        schema.TypeBool,
+				Description: "Determines whether the zone is disabled.",
+				Optional:    true,
+				Default:     false,
+			},
+			"extensible_attribute": extensibleAttributeBlockSchema(),
+			"extensible_attributes": {
+				Type:             schema.TypeMap,
+				Description:      "Extensible attributes of the zone (Values are JSON encoded).",
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateEa,
+				DiffSuppressFunc: eaSuppressDiff,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"forward_to": externalServerSchema("Name servers queries for the zone are forwarded to.", true),
+			"forwarders_only": {
+				Type:        schema.TypeBool,
+				Description: "Only send queries for the zone to the forwarders instead of falling back to recursion.",
+				Optional:    true,
+				Default:     false,
+			},
+			"forwarding_servers": {
+				Type:        schema.TypeList,
+				Description: "Grid members that forward queries for the zone.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"forward_to": externalServerSchema("Forwarders used by this member instead of the zone forward_to list.", false),
+						"forwarders_only": {
+							Type:        schema.TypeBool,
+							Description: "Only send queries for the zone from this member to the forwarders.",
+							Optional:    true,
+							Default:     false,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Hostname of the grid member.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"fqdn": {
+				Type:        schema.TypeString,
+				Description: "The name of the zone. Reverse zones use IPv4 or IPv6 Address/CIDR format.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"grid_ref": {
+				Type:         schema.TypeString,
+				Description:  "Ref for grid needed for restarting services.",
+				Optional:     true,
+				RequiredWith: []string{"restart_if_needed"},
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Description: "Reference id of zone object.",
+				Computed:    true,
+			},
+			"restart_if_needed": {
+				Type:        schema.TypeBool,
+				Description: "Restart dns services on the zone's forwarding members if needed.",
+				Optional:    true,
+			},
+			"view": {
+				Type:        schema.TypeString,
+				Description: "The name of the DNS view in which the zone resides.",
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+			},
+			"zone_format": {
+				Type:             schema.TypeString,
+				Description:      "Format of the zone: FORWARD, IPV4 or IPV6.",
+				Optional:         true,
+				Default:          "FORWARD",
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validZoneFormats, false)),
+			},
+		},
+	}
+}
+
+func convertForwardingServersToList(servers *[]infoblox.ForwardingMemberServer) []map[string]interface{} {
+	var list []map[string]interface{}
+	if servers == nil {
+		return list
+	}
+	for _, server := range *servers {
+		item := map[string]interface{}{
+			"name":            server.Name,
+			"forwarders_only": server.ForwardersOnly != nil && *server.ForwardersOnly,
+		}
+		if server.UseOverrideForwarders != nil && *server.UseOverrideForwarders {
+			item["forward_to"] = convertExternalServersToList(server.ForwardTo)
+		}
+		list = append(list, item)
+	}
+	return list
+}
+
+func convertListToForwardingServers(list []interface{}) []infoblox.ForwardingMemberServer {
+	servers := []infoblox.ForwardingMemberServer{}
+	for _, item := range list {
+		server := item.(map[string]interface{})
+		forwardTo := convertListToExternalServers(server["forward_to"].([]interface{}))
+		member := infoblox.ForwardingMemberServer{
+			Name:                  server["name"].(string),
+			ForwardersOnly:        newBool(server["forwarders_only"].(bool)),
+			UseOverrideForwarders: newBool(len(forwardTo) > 0),
+		}
+		if len(forwardTo) > 0 {
+			member.ForwardTo = &forwardTo
+		}
+		servers = append(servers, member)
+	}
+	return servers
+}
+
+// zoneForwardMembers returns the grid member names forwarding the zone
+func zoneForwardMembers(servers []interface{}) []string {
+	var members []string
+	for _, server := range servers {
+		name := server.(map[string]interface{})["name"].(string)
+		if !Contains(members, name) {
+			members = append(members, name)
+		}
+	}
+	return members
+}
+
+func convertZoneForwardToResourceData(client *infoblox.Client, d *schema.ResourceData, zone *infoblox.ZoneForward) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.Set("ref", zone.Ref)
+	d.Set("fqdn", zone.FQDN)
+	d.Set("view", zone.View)
+	d.Set("zone_format", zone.ZoneFormat)
+	d.Set("comment", zone.Comment)
+	d.Set("disable", zone.Disable != nil && *zone.Disable)
+	d.Set("forward_to", convertExternalServersToList(zone.ForwardTo))
+	d.Set("forwarders_only", zone.ForwardersOnly != nil && *zone.ForwardersOnly)
+	d.Set("forwarding_servers", convertForwardingServersToList(zone.ForwardingServers))
+
+	eas, err := client.ConvertEAsToJSONString(*zone.ExtensibleAttributes)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	} else {
+		d.Set("extensible_attributes", eas)
+	}
+
+	return diags
+}
+
+func convertResourceDataToZoneForward(client *infoblox.Client, d *schema.ResourceData) (*infoblox.ZoneForward, error) {
+	var zone infoblox.ZoneForward
+
+	zone.FQDN = d.Get("fqdn").(string)
+	zone.View = d.Get("view").(string)
+	zone.ZoneFormat = d.Get("zone_format").(string)
+	zone.Comment = d.Get("comment").(string)
+	zone.Disable = newBool(d.Get("disable").(bool))
+	zone.ForwardersOnly = newBool(d.Get("forwarders_only").(bool))
+
+	forwardTo := convertListToExternalServers(d.Get("forward_to").([]interface{}))
+	zone.ForwardTo = &forwardTo
+
+	if members := d.Get("forwarding_servers").([]interface{}); len(members) > 0 {
+		servers := convertListToForwardingServers(members)
+		zone.ForwardingServers = &servers
+	}
+
+	eaMap := d.Get("extensible_attributes").(map[string]interface{})
+	if len(eaMap) > 0 {
+		eas, err := createExtensibleAttributesFromJSON(eaMap)
+		if err != nil {
+			return &zone, err
+		}
+		zone.ExtensibleAttributes = &eas
+	}
+
+	if client.OrchestratorEAs != nil && len(*client.OrchestratorEAs) > 0 {
+		if zone.ExtensibleAttributes == nil {
+			zone.ExtensibleAttributes = &infoblox.ExtensibleAttribute{}
+		}
+		for k, v := range *client.OrchestratorEAs {
+			(*zone.ExtensibleAttributes)[k] = v
+		}
+	}
+
+	return &zone, nil
+}
+
+func resourceZoneForwardRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	zone, err := client.GetZoneForwardByRef(ref, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			log.Printf("[WARN] forward zone %s not found, removing from state", ref)
+			d.SetId("")
+			return diags
+		}
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	check := convertZoneForwardToResourceData(client, d, &zone)
+	if check.HasError() {
+		return check
+	}
+
+	d.SetId(zone.Ref)
+
+	return diags
+}
+
+func resourceZoneForwardCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+
+	zone, err := convertResourceDataToZoneForward(client, d)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	err = client.CreateZoneForward(zone)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	d.SetId(zone.Ref)
+
+	err = restartZoneAuthMembers(client, d, zoneForwardMembers(d.Get("forwarding_servers").([]interface{})))
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	return resourceZoneForwardRead(ctx, d, m)
+}
+
+func resourceZoneForwardUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*infoblox.Client)
+
+	var zone infoblox.ZoneForward
+
+	if d.HasChange("comment") {
+		zone.Comment = d.Get("comment").(string)
+	}
+	if d.HasChange("disable") {
+		zone.Disable = newBool(d.Get("disable").(bool))
+	}
+	if d.HasChange("forward_to") {
+		servers := convertListToExternalServers(d.Get("forward_to").([]interface{}))
+		zone.ForwardTo = &servers
+	}
+	if d.HasChange("forwarders_only") {
+		zone.ForwardersOnly = newBool(d.Get("forwarders_only").(bool))
+	}
+	if d.HasChange("forwarding_servers") {
+		servers := convertListToForwardingServers(d.Get("forwarding_servers").([]interface{}))
+		zone.ForwardingServers = &servers
+	}
+	if d.HasChange("extensible_attributes") {
+		old, new := d.GetChange("extensible_attributes")
+		oldKeys := Keys(old.(map[string]interface{}))
+		oldEAs, err := createExtensibleAttributesFromJSON(old.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		newKeys := Keys(new.(map[string]interface{}))
+		newEAs, err := createExtensibleAttributesFromJSON(new.(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		removeEAs := sliceDiff(oldKeys, newKeys, false)
+		if len(removeEAs) > 0 {
+			zone.ExtensibleAttributesRemove = &infoblox.ExtensibleAttribute{}
+			for _, v := range removeEAs {
+				(*zone.ExtensibleAttributesRemove)[v] = oldEAs[v]
+			}
+		}
+		for k, v := range newEAs {
+			if !Contains(oldKeys, k) || (Contains(oldKeys, k) && v.Value != oldEAs[k].Value) {
+				if zone.ExtensibleAttributesAdd == nil {
+					zone.ExtensibleAttributesAdd = &infoblox.ExtensibleAttribute{}
+				}
+				(*zone.ExtensibleAttributesAdd)[k] = v
+			}
+		}
+		if client.OrchestratorEAs != nil && len(*client.OrchestratorEAs) > 0 {
+			if zone.ExtensibleAttributesAdd == nil {
+				zone.ExtensibleAttributesAdd = &infoblox.ExtensibleAttribute{}
+			}
+			for k, v := range *client.OrchestratorEAs {
+				(*zone.ExtensibleAttributesAdd)[k] = v
+			}
+		}
+	}
+
+	changedZone, err := client.UpdateZoneForward(d.Id(), zone)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	// Members removed from the zone also need to reload their configuration
+	oldServers, newServers := d.GetChange("forwarding_servers")
+	members := zoneForwardMembers(newServers.([]interface{}))
+	for _, member := range zoneForwardMembers(oldServers.([]interface{})) {
+		if !Contains(members, member) {
+			members = append(members, member)
+		}
+	}
+	err = restartZoneAuthMembers(client, d, members)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+		return diags
+	}
+
+	d.SetId(changedZone.Ref)
+	return resourceZoneForwardRead(ctx, d, m)
+}
+
+func resourceZoneForwardDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*infoblox.Client)
+
+	var diags diag.Diagnostics
+	ref := d.Id()
+
+	err := client.DeleteZoneForward(ref)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = restartZoneAuthMembers(client, d, zoneForwardMembers(d.Get("forwarding_servers").([]interface{})))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
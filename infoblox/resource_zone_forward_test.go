@@ -0,0 +1,162 @@
+package infoblox
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccInfobloxZoneForwardBasic(t *testing.T) {
+	testAccSteps(t, testInfobloxZoneForwardSteps)
+}
+
+func TestUnitInfobloxZoneForwardBasic(t *testing.T) {
+	testUnitSteps(t, testInfobloxZoneForwardSteps)
+}
+
+func testInfobloxZoneForwardSteps(providerConfig string) []resource.TestStep {
+	return []resource.TestStep{
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxZoneForwardCreate()),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxZoneForwardExists("infoblox_zone_forward.new"),
+				resource.TestCheckResourceAttr("infoblox_zone_forward.new", "fqdn", "ad.terraform-zone.example.com"),
+				resource.TestCheckResourceAttr("infoblox_zone_forward.new", "comment", "test forward zone"),
+				resource.TestCheckResourceAttr("infoblox_zone_forward.new", "forward_to.#", "1"),
+				resource.TestCheckResourceAttr("infoblox_zone_forward.new", "forward_to.0.address", "10.10.0.10"),
+				resource.TestCheckResourceAttr("infoblox_zone_forward.new", "forwarders_only", "true"),
+				resource.TestCheckResourceAttr("infoblox_zone_forward.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation\",\"type\":\"STRING\"}"),
+				resource.TestCheckResourceAttr("infoblox_zone_forward.new", "extensible_attributes.Orchestrator", "{\"value\":\"Terraform\",\"type\":\"ENUM\"}"),
+			),
+		},
+		{
+			Config: composeConfig(providerConfig, testAccCheckInfobloxZoneForwardUpdate()),
+			Check: resource.ComposeTestCheckFunc(
+				testAccCheckInfobloxZoneForwardExists("infoblox_zone_forward.new"),
+				resource.TestCheckResourceAttr("infoblox_zone_forward.new", "comment", "test forward zone update"),
+				resource.TestCheckResourceAttr("infoblox_zone_forward.new", "forward_to.#", "2"),
+				resource.TestCheckResourceAttr("infoblox_zone_forward.new", "forwarders_only", "false"),
+				resource.TestCheckResourceAttr("infoblox_zone_forward.new", "extensible_attributes.Location", "{\"value\":\"CollegeStation2\",\"type\":\"STRING\"}"),
+			),
+		},
+		{
+			ResourceName:      "infoblox_zone_forward.new",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+}
+
+func TestUnitResourceZoneForwardMembers(t *testing.T) {
+	fake := newFakeWAPI(t)
+	client := fake.client()
+	gridRef := fake.refs("grid")[0]
+	r := resourceZoneForward()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"fqdn":              "unit-forward.example.com",
+		"grid_ref":          gridRef,
+		"restart_if_needed": true,
+		"forward_to": []interface{}{
+			map[string]interface{}{"name": "dc1.ad.example.com", "address": "10.10.0.10"},
+		},
+		"forwarding_servers": []interface{}{
+			map[string]interface{}{"name": "infoblox1.example.com"},
+			map[string]interface{}{
+				"name":            "infoblox2.example.com",
+				"forwarders_only": true,
+				"forward_to": []interface{}{
+					map[string]interface{}{"name": "dc2.ad.example.com", "address": "10.20.0.10"},
+				},
+			},
+		},
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("create: %+v", diags)
+	}
+	zone, ok := fake.lookup(d.Id())
+	if !ok {
+		t.Fatalf("create: zone %s not found in fake grid", d.Id())
+	}
+	servers := zone["forwarding_servers"].([]interface{})
+	if override := servers[0].(map[string]interface{})["use_override_forwarders"]; override != false {
+		t.Fatalf("expected the first member to use the zone forwarders, found %v", override)
+	}
+	if override := servers[1].(map[string]interface{})["use_override_forwarders"]; override != true {
+		t.Fatalf("expected the second member to override the zone forwarders, found %v", override)
+	}
+	if d.Get("forwarding_servers.1.forward_to.0.address").(string) != "10.20.0.10" {
+		t.Fatal("expected member forwarders to be read back into state")
+	}
+	if d.Get("forwarding_servers.0.forward_to.#").(int) != 0 {
+		t.Fatal("expected no member forwarders without an override")
+	}
+
+	restarts := fake.restartRequests()
+	if len(restarts) != 1 || fmt.Sprint(restarts[0]["members"]) != "[infoblox1.example.com infoblox2.example.com]" {
+		t.Fatalf("expected a restart of both forwarding members, found %v", restarts)
+	}
+}
+
+func testAccCheckInfobloxZoneForwardExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource: %s not set", resourceName)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckInfobloxZoneForwardCreate() string {
+	return `
+  resource "infoblox_zone_forward" "new" {
+    fqdn            = "ad.terraform-zone.example.com"
+    comment         = "test forward zone"
+    forwarders_only = true
+    forward_to {
+      name    = "dc1.ad.terraform-zone.example.com"
+      address = "10.10.0.10"
+    }
+    extensible_attributes = {
+      Location = jsonencode({
+        value = "CollegeStation",
+        type  = "STRING"
+      })
+    }
+  }
+`
+}
+
+func testAccCheckInfobloxZoneForwardUpdate() string {
+	return `
+  resource "infoblox_zone_forward" "new" {
+    fqdn    = "ad.terraform-zone.example.com"
+    comment = "test forward zone update"
+    forward_to {
+      name    = "dc1.ad.terraform-zone.example.com"
+      address = "10.10.0.10"
+    }
+    forward_to {
+      name    = "dc2.ad.terraform-zone.example.com"
+      address = "10.10.0.11"
+    }
+    extensible_attributes = {
+      Location = jsonencode({
+        value = "CollegeStation2",
+        type  = "STRING"
+      })
+    }
+  }
+`
+}
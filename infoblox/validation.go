@@ -3,10 +3,18 @@ package infoblox
 import (
 	"encoding/json"
 	"fmt"
+	"net/mail"
+	"net/netip"
+	"net/url"
 	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	infoblox "github.com/techBeck03/infoblox-go-sdk"
@@ -46,6 +54,21 @@ var (
 		"INHERIT",
 		"NOT_INHERIT",
 	}
+	validZoneFormats = []string{
+		"FORWARD",
+		"IPV4",
+		"IPV6",
+	}
+	validAddressACPermissions = []string{
+		"ALLOW",
+		"DENY",
+	}
+	validEAFlags       = regexp.MustCompile(`^[ACGILMPRSV]*$`)
+	validEADateFormats = []string{
+		"2006-01-02",
+		"2006-01-02 15:04:05",
+		time.RFC3339,
+	}
 )
 
 // func validateEa(eaMap map[string]interface{}) (diags diag.Diagnostics) {
@@ -108,6 +131,112 @@ func validateEa(i interface{}, p cty.Path) (diags diag.Diagnostics) {
 	return diags
 }
 
+// validateEADefinitions checks configured extensible attributes against their
+// definitions on the grid so bad values are caught when planning. Attributes
+// whose definition is created or changed in the same plan are not checked.
+// Each invalid attribute is reported as its own error.
+func validateEADefinitions(path string, eaMap map[string]interface{}, client *infoblox.Client) error {
+	err := client.GetEADefinitions(true)
+	if err != nil {
+		return err
+	}
+	names := Keys(eaMap)
+	sort.Strings(names)
+	var result *multierror.Error
+	for _, name := range names {
+		raw, ok := eaMap[name].(string)
+		if !ok || !gjson.Valid(raw) {
+			continue
+		}
+		// Definitions planned in the same run may not match the grid yet
+		if _, planned := client.PlannedEADefinition(name); planned {
+			continue
+		}
+		attributePath := fmt.Sprintf("%s.%s", path, name)
+		def, err := client.GetEADefinition(name)
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("%s: No extensible attribute definition found for %s", attributePath, name))
+			continue
+		}
+		if details := checkEADefinition(def, gjson.Parse(raw)); len(details) > 0 {
+			result = multierror.Append(result, fmt.Errorf("%s: %s", attributePath, strings.Join(details, "; ")))
+		}
+	}
+	return result.ErrorOrNil()
+}
+
+func checkEADefinition(def infoblox.EADefinition, parsed gjson.Result) (details []string) {
+	if parsed.Get("type").Exists() && parsed.Get("type").String() != def.Type {
+		details = append(details, fmt.Sprintf("Expected type to be %s but found %s", def.Type, parsed.Get("type").String()))
+	}
+	inherits := parsed.Get("inheritance_operation").Str == "INHERIT"
+	if (parsed.Get("inheritance_operation").Exists() || parsed.Get("descendants_action").Exists()) && !strings.Contains(def.Flags, "I") {
+		details = append(details, "Extensible attribute definition is not inheritable")
+	}
+	value := parsed.Get("value")
+	if !value.Exists() || value.String() == "" {
+		if strings.Contains(def.Flags, "V") && !inherits {
+			details = append(details, "Extensible attribute definition requires a value")
+		}
+		return details
+	}
+	if strings.Contains(def.Flags, "R") {
+		details = append(details, "Extensible attribute definition is read only")
+	}
+	switch def.Type {
+	case "INTEGER":
+		intValue, err := strconv.ParseInt(value.String(), 10, 64)
+		if err != nil {
+			details = append(details, fmt.Sprintf("Expected an integer value but found %s", value.String()))
+			break
+		}
+		if def.Min != nil && intValue < int64(*def.Min) {
+			details = append(details, fmt.Sprintf("Expected value to be at least %d but found %d", *def.Min, intValue))
+		}
+		if def.Max != nil && intValue > int64(*def.Max) {
+			details = append(details, fmt.Sprintf("Expected value to be at most %d but found %d", *def.Max, intValue))
+		}
+	case "STRING":
+		length := len(value.String())
+		if def.Min != nil && length < *def.Min {
+			details = append(details, fmt.Sprintf("Expected value to be at least %d characters but found %d", *def.Min, length))
+		}
+		if def.Max != nil && length > *def.Max {
+			details = append(details, fmt.Sprintf("Expected value to be at most %d characters but found %d", *def.Max, length))
+		}
+	case "ENUM":
+		var listValues []string
+		for _, listValue := range def.ListValues {
+			listValues = append(listValues, listValue.Value)
+		}
+		if !Contains(listValues, value.String()) {
+			details = append(details, fmt.Sprintf("Expected value to be one of %s but found %s", strings.Join(listValues, ", "), value.String()))
+		}
+	case "EMAIL":
+		address, err := mail.ParseAddress(value.String())
+		if err != nil || address.Address != value.String() {
+			details = append(details, fmt.Sprintf("Expected a valid email address but found %s", value.String()))
+		}
+	case "URL":
+		parsedURL, err := url.ParseRequestURI(value.String())
+		if err != nil || parsedURL.Scheme == "" || parsedURL.Host == "" {
+			details = append(details, fmt.Sprintf("Expected a valid URL but found %s", value.String()))
+		}
+	case "DATE":
+		valid := false
+		for _, format := range validEADateFormats {
+			if _, err := time.Parse(format, value.String()); err == nil {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			details = append(details, fmt.Sprintf("Expected a date formatted as %s but found %s", strings.Join(validEADateFormats, " or "), value.String()))
+		}
+	}
+	return details
+}
+
 func stringInSlice(valid []string, test []string, subject string) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -130,6 +259,62 @@ func stringInSlice(valid []string, test []string, subject string) diag.Diagnosti
 	return diags
 }
 
+// validateIPv4CIDR checks that i is an IPv4 network in Address/CIDR format
+func validateIPv4CIDR(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+	prefix, err := netip.ParsePrefix(v)
+	if err != nil || !prefix.Addr().Is4() {
+		errors = append(errors, fmt.Errorf("expected %s to be an IPv4 network in Address/CIDR format, got: %s", k, v))
+	}
+	return warnings, errors
+}
+
+// validateIPv6CIDR checks that i is an IPv6 network in Address/CIDR format
+func validateIPv6CIDR(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+	prefix, err := netip.ParsePrefix(v)
+	if err != nil || !prefix.Addr().Is6() || prefix.Addr().Is4In6() {
+		errors = append(errors, fmt.Errorf("expected %s to be an IPv6 network in Address/CIDR format, got: %s", k, v))
+	}
+	return warnings, errors
+}
+
+// ipv6AddressSuppressDiff ignores differences between two spellings of the
+// same IPv6 address, as the grid stores addresses in their canonical form
+func ipv6AddressSuppressDiff(k, old, new string, d *schema.ResourceData) bool {
+	oldAddr, err := netip.ParseAddr(old)
+	if err != nil {
+		return false
+	}
+	newAddr, err := netip.ParseAddr(new)
+	if err != nil {
+		return false
+	}
+	return oldAddr == newAddr
+}
+
+// ipv6CIDRSuppressDiff ignores differences between two spellings of the same
+// IPv6 network, as the grid stores networks in their canonical form
+func ipv6CIDRSuppressDiff(k, old, new string, d *schema.ResourceData) bool {
+	oldPrefix, err := netip.ParsePrefix(old)
+	if err != nil {
+		return false
+	}
+	newPrefix, err := netip.ParsePrefix(new)
+	if err != nil {
+		return false
+	}
+	return oldPrefix == newPrefix
+}
+
 func eaSuppressDiff(k, old, new string, d *schema.ResourceData) bool {
 	areEqual, err := areEqualJSON(old, new)
 	if err != nil {
@@ -0,0 +1,112 @@
+package infoblox
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	aaaaRecordBasePath     = "record:aaaa"
+	aaaaRecordReturnFields = "ipv6addr,name,view,dns_name,disable,comment,zone,ttl,use_ttl,extattrs"
+)
+
+// GetAAAARecordByRef gets AAAA record by reference
+func (c *Client) GetAAAARecordByRef(ref string, queryParams map[string]string) (AAAARecord, error) {
+	var ret AAAARecord
+	if queryParams == nil {
+		queryParams = map[string]string{
+			"_return_fields": aaaaRecordReturnFields,
+		}
+	} else {
+		queryParams["_return_fields"] = aaaaRecordReturnFields
+	}
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetAAAARecordByQuery gets AAAA records by query parameters
+func (c *Client) GetAAAARecordByQuery(queryParams map[string]string) ([]AAAARecord, error) {
+	var ret []AAAARecord
+	queryParams["_return_fields"] = aaaaRecordReturnFields
+	queryParams["_paging"] = "0"
+	queryParams["_max_results"] = "2"
+
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", aaaaRecordBasePath, queryParamString), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return nil, fmt.Errorf(response.ErrorMessage)
+	}
+
+	return ret, nil
+}
+
+// CreateAAAARecord creates AAAA record
+func (c *Client) CreateAAAARecord(record *AAAARecord) error {
+	queryParams := map[string]string{
+		"_return_fields": aaaaRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", aaaaRecordBasePath, queryParamString), record)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &record)
+	if response != nil {
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
+
+// UpdateAAAARecord updates AAAA record
+func (c *Client) UpdateAAAARecord(ref string, record AAAARecord) (AAAARecord, error) {
+	var ret AAAARecord
+	queryParams := map[string]string{
+		"_return_fields": aaaaRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), record)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, fmt.Errorf(response.ErrorMessage)
+	}
+	return ret, nil
+}
+
+// DeleteAAAARecord deletes AAAA record
+func (c *Client) DeleteAAAARecord(ref string) error {
+	request, err := c.CreateJSONRequest(http.MethodDelete, ref, nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
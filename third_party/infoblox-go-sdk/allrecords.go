@@ -0,0 +1,45 @@
+package infoblox
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	allRecordsBasePath     = "allrecords"
+	allRecordsReturnFields = "address,comment,disable,name,record,ttl,type,view,zone"
+)
+
+// GetAllRecordsByQuery gets every record of a zone matching the query
+// parameters, following result pages until the last one is returned
+func (c *Client) GetAllRecordsByQuery(queryParams map[string]string) ([]AllRecord, error) {
+	ret := []AllRecord{}
+	queryParams["_return_fields"] = allRecordsReturnFields
+	queryParams["_return_as_object"] = "1"
+	queryParams["_paging"] = "1"
+	if _, ok := queryParams["_max_results"]; !ok {
+		queryParams["_max_results"] = "1000"
+	}
+
+	for {
+		var page AllRecordQueryResult
+		queryParamString := c.BuildQuery(queryParams)
+		request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", allRecordsBasePath, queryParamString), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		response := c.Call(request, &page)
+		if response != nil {
+			return nil, fmt.Errorf(response.ErrorMessage)
+		}
+
+		ret = append(ret, page.Results...)
+		if page.NextPageID == "" {
+			break
+		}
+		queryParams["_page_id"] = page.NextPageID
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,318 @@
+package infoblox
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRetryWaitMin = 1 * time.Second
+	defaultRetryWaitMax = 30 * time.Second
+)
+
+// Config - Configuration details for connecting to infoblox
+type Config struct {
+	Host                   string
+	Port                   string
+	Version                string
+	Username               string
+	Password               string
+	DisableTLSVerification bool
+	// MaxRetries is the number of times a failed request is retried
+	MaxRetries int
+	// RetryWaitMin is the initial wait between retries
+	RetryWaitMin time.Duration
+	// RetryWaitMax is the maximum wait between retries
+	RetryWaitMax time.Duration
+}
+
+// Client - base client for infoblox interactions
+type Client struct {
+	client               *http.Client
+	config               Config
+	baseURL              string
+	cookies              []*http.Cookie
+	eaDefinitions        []EADefinition
+	plannedEADefinitions map[string]EADefinition
+	eaDefinitionsLock    sync.RWMutex
+	OrchestratorEAs      *ExtensibleAttribute
+	SequentialLock       sync.Mutex
+}
+
+// New - creates a new infoblox client
+func New(config Config) Client {
+	var client *http.Client
+	if config.DisableTLSVerification {
+		transport := &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}
+		client = &http.Client{Transport: transport}
+	} else {
+		client = http.DefaultClient
+	}
+	if config.RetryWaitMin <= 0 {
+		config.RetryWaitMin = defaultRetryWaitMin
+	}
+	if config.RetryWaitMax <= 0 {
+		config.RetryWaitMax = defaultRetryWaitMax
+	}
+	if config.RetryWaitMax < config.RetryWaitMin {
+		config.RetryWaitMax = config.RetryWaitMin
+	}
+	return Client{
+		client:  client,
+		config:  config,
+		baseURL: fmt.Sprintf("https://%s:%s/wapi/v%s", config.Host, config.Port, config.Version),
+	}
+}
+
+// BuildQuery creates query string
+func (c *Client) BuildQuery(params map[string]string) string {
+	q := url.Values{}
+	for k, v := range params {
+		q.Add(k, v)
+	}
+	return q.Encode()
+}
+
+// CreateJSONRequest - helper function for creating json based http requests
+func (c *Client) CreateJSONRequest(method string, path string, params interface{}) (*http.Request, error) {
+	var request *http.Request
+	var buf bytes.Buffer
+
+	err := json.NewEncoder(&buf).Encode(&params)
+	if err != nil {
+		return request, err
+	}
+	combinedPath := fmt.Sprintf("%s/%s", c.baseURL, path)
+	request, err = http.NewRequest(method, combinedPath, &buf)
+	if err != nil {
+		return request, err
+	}
+	if buf.Len() == 0 {
+		request.Body = http.NoBody
+	}
+	request.Header.Set("Content-Type", "application/json")
+	return request, nil
+}
+
+// Call - function for handling http requests
+func (c *Client) Call(request *http.Request, result interface{}) *ResponseError {
+	request.SetBasicAuth(c.config.Username, c.config.Password)
+
+	// Use cookies for auth if set
+	if len(c.cookies) > 0 {
+		for i := range c.cookies {
+			request.AddCookie(c.cookies[i])
+		}
+	}
+	for attempt := 0; ; attempt++ {
+		responseError, wait, retry := c.do(request, result)
+		// An earlier attempt of a retried delete may have gone through before
+		// the connection failed, so the object being gone is a success
+		if responseError != nil && attempt > 0 && request.Method == http.MethodDelete && responseError.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		if responseError == nil || !retry || attempt >= c.config.MaxRetries {
+			return responseError
+		}
+		if wait <= 0 {
+			wait = c.retryWait(attempt)
+		}
+		log.Printf("[WARN] %s %s failed with status code %d (attempt %d of %d), retrying in %s",
+			request.Method, request.URL.Path, responseError.StatusCode, attempt+1, c.config.MaxRetries+1, wait)
+		time.Sleep(wait)
+		// Rewind the request body for the next attempt
+		if request.GetBody != nil {
+			body, err := request.GetBody()
+			if err != nil {
+				return responseError
+			}
+			request.Body = body
+		}
+	}
+}
+
+// do performs a single attempt of request and reports whether a failure can
+// safely be retried along with any wait requested by the server
+func (c *Client) do(request *http.Request, result interface{}) (*ResponseError, time.Duration, bool) {
+	response, err := c.client.Do(request)
+	if err != nil {
+		return &ResponseError{
+			StatusCode:   0,
+			Request:      fmt.Sprintf("%+v", request),
+			ResponseBody: "",
+			ErrorMessage: fmt.Sprint(err),
+		}, 0, isRetryable(request, 0, "")
+	}
+	defer response.Body.Close()
+	if !(response.StatusCode >= 200 && response.StatusCode <= 299) {
+		var rawBodyBuffer bytes.Buffer
+		// Decode raw response, usually contains
+		// additional error details
+		body := io.TeeReader(response.Body, &rawBodyBuffer)
+		var responseBody interface{}
+		json.NewDecoder(body).Decode(&responseBody)
+		responseError := &ResponseError{
+			StatusCode:   response.StatusCode,
+			Request:      fmt.Sprintf("%+v", request),
+			ResponseBody: fmt.Sprintf("%+v", responseBody),
+			ErrorMessage: fmt.Sprintf("Request %+v\n failed with status code %d\n response %+v", request,
+				response.StatusCode, responseBody),
+		}
+		return responseError, c.retryAfter(response), isRetryable(request, response.StatusCode, rawBodyBuffer.String())
+	}
+
+	// Add cookies if none exist
+	if len(c.cookies) == 0 {
+		c.cookies = response.Request.Cookies()
+	}
+	// If no result is expected, don't attempt to decode a potentially
+	// empty response stream and avoid incurring EOF errors
+	if result == nil {
+		return nil, 0, false
+	}
+	err = json.NewDecoder(response.Body).Decode(&result)
+	if err != nil {
+		return &ResponseError{
+			StatusCode:   0,
+			Request:      fmt.Sprintf("%+v", request),
+			ResponseBody: "",
+			ErrorMessage: fmt.Sprint(err),
+		}, 0, false
+	}
+	return nil, 0, false
+}
+
+// isRetryable tells whether a failed request can be sent again without
+// risking a duplicate change on the grid
+func isRetryable(request *http.Request, statusCode int, body string) bool {
+	// WAPI rejected the transaction before committing anything
+	if isTransientWAPIError(body) {
+		return true
+	}
+	// A POST or PUT may have been applied when the connection dropped or a
+	// proxy gave up waiting, and allocating from a next available function
+	// must never be repeated unless the grid explicitly rejected the request
+	if (request.Method == http.MethodPost || request.Method == http.MethodPut) && allocatesNextAvailable(request) {
+		return false
+	}
+	switch request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		switch statusCode {
+		case 0, http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+	case http.MethodPost:
+		switch statusCode {
+		case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+			return true
+		}
+	}
+	return false
+}
+
+// isTransientWAPIError tells whether body is a WAPI error reporting a
+// temporary condition such as a locked database. Error pages from proxies in
+// front of the grid are not WAPI errors, even when they read as temporary
+func isTransientWAPIError(body string) bool {
+	var wapiError struct {
+		Error string `json:"Error"`
+		Code  string `json:"code"`
+		Text  string `json:"text"`
+	}
+	if err := json.Unmarshal([]byte(body), &wapiError); err != nil || (wapiError.Error == "" && wapiError.Code == "") {
+		return false
+	}
+	message := strings.ToLower(wapiError.Error + " " + wapiError.Text)
+	for _, transient := range []string{"database is locked", "temporarily unavailable"} {
+		if strings.Contains(message, transient) {
+			return true
+		}
+	}
+	return false
+}
+
+// allocatesNextAvailable tells whether request allocates from a next
+// available function, either as a func:nextavailable* value or as an
+// _object_function such as next_available_network
+func allocatesNextAvailable(request *http.Request) bool {
+	if containsNextAvailable(request.URL.RawQuery) {
+		return true
+	}
+	if request.GetBody == nil {
+		// Body can't be inspected so assume the worst
+		return request.Body != nil && request.Body != http.NoBody
+	}
+	body, err := request.GetBody()
+	if err != nil {
+		return true
+	}
+	defer body.Close()
+	raw, err := io.ReadAll(body)
+	if err != nil {
+		return true
+	}
+	return containsNextAvailable(string(raw)) || strings.Contains(string(raw), `"_object_function"`)
+}
+
+// containsNextAvailable tells whether s names a next available function
+func containsNextAvailable(s string) bool {
+	return strings.Contains(s, "nextavailable") || strings.Contains(s, "next_available")
+}
+
+// retryWait returns the jittered exponential backoff for attempt
+func (c *Client) retryWait(attempt int) time.Duration {
+	wait := c.config.RetryWaitMax
+	if attempt < 32 {
+		if backoff := c.config.RetryWaitMin * time.Duration(1<<uint(attempt)); backoff > 0 && backoff < wait {
+			wait = backoff
+		}
+	}
+	// Wait somewhere between half and all of the backoff
+	half := int64(wait / 2)
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// retryAfter returns the wait requested by a Retry-After header capped at the
+// configured maximum
+func (c *Client) retryAfter(response *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(response.Header.Get("Retry-After"))
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	wait := time.Duration(seconds) * time.Second
+	if wait > c.config.RetryWaitMax {
+		wait = c.config.RetryWaitMax
+	}
+	return wait
+}
+
+// Error returns the error message of a failed request
+func (e *ResponseError) Error() string {
+	return e.ErrorMessage
+}
+
+// Logout clears auth cookie
+func (c *Client) Logout() error {
+	request, err := c.CreateJSONRequest(http.MethodPost, "logout", nil)
+	if err != nil {
+		return err
+	}
+	response := c.Call(request, nil)
+	if response != nil {
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package infoblox
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	dnsViewBasePath     = "view"
+	dnsViewReturnFields = "comment,disable,extattrs,forward_only,forwarders,is_default,match_clients,match_destinations,name,network_view,recursion,use_forwarders"
+)
+
+// GetDNSViewByRef gets DNS view by reference
+func (c *Client) GetDNSViewByRef(ref string, queryParams map[string]string) (DNSView, error) {
+	var ret DNSView
+	if queryParams == nil {
+		queryParams = map[string]string{
+			"_return_fields": dnsViewReturnFields,
+		}
+	} else {
+		queryParams["_return_fields"] = dnsViewReturnFields
+	}
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetDNSViewByQuery gets DNS views by query parameters
+func (c *Client) GetDNSViewByQuery(queryParams map[string]string) ([]DNSView, error) {
+	var ret []DNSView
+	queryParams["_return_fields"] = dnsViewReturnFields
+	queryParams["_paging"] = "0"
+	queryParams["_max_results"] = "2"
+
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", dnsViewBasePath, queryParamString), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return nil, fmt.Errorf(response.ErrorMessage)
+	}
+
+	return ret, nil
+}
+
+// CreateDNSView creates DNS view
+func (c *Client) CreateDNSView(view *DNSView) error {
+	queryParams := map[string]string{
+		"_return_fields": dnsViewReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", dnsViewBasePath, queryParamString), view)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &view)
+	if response != nil {
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
+
+// UpdateDNSView updates DNS view
+func (c *Client) UpdateDNSView(ref string, view DNSView) (DNSView, error) {
+	var ret DNSView
+	queryParams := map[string]string{
+		"_return_fields": dnsViewReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), view)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, fmt.Errorf(response.ErrorMessage)
+	}
+	return ret, nil
+}
+
+// DeleteDNSView deletes DNS view
+func (c *Client) DeleteDNSView(ref string) error {
+	request, err := c.CreateJSONRequest(http.MethodDelete, ref, nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
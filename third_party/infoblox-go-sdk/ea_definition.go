@@ -0,0 +1,229 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	eaDefintionBasePath      = "extensibleattributedef"
+	eaDefinitionReturnFields = "allowed_object_types,comment,default_value,flags,list_values,max,min,name,namespace,type"
+)
+
+// GetEADefinitions retrieves extensible attribute definitions
+func (c *Client) GetEADefinitions(force bool) error {
+	var ret []EADefinition
+
+	c.eaDefinitionsLock.Lock()
+	defer c.eaDefinitionsLock.Unlock()
+
+	if len(c.eaDefinitions) > 0 && force != false {
+		return nil
+	}
+	queryParams := map[string]string{
+		"_return_fields": "name,default_value,type,min,max,list_values,flags",
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", eaDefintionBasePath, queryParamString), nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return fmt.Errorf(response.ErrorMessage)
+	}
+
+	c.eaDefinitions = ret
+
+	return nil
+}
+
+// ConvertEAsToJSONString converts extensible attributes to json format
+func (c *Client) ConvertEAsToJSONString(eas ExtensibleAttribute) (map[string]string, error) {
+	ret := make(map[string]string)
+	c.GetEADefinitions(true)
+	for name, ea := range eas {
+		target, ok := c.PlannedEADefinition(name)
+		if !ok {
+			target, ok = c.cachedEADefinition(name)
+		}
+		if !ok {
+			return ret, fmt.Errorf("No ea definition found for ea: %s", name)
+		}
+		stringVal, _ := json.Marshal(ExtensibleAttributeJSONMapValue{
+			Type:                 target.Type,
+			Value:                ea.Value,
+			InheritanceSource:    ea.InheritanceSource,
+			InheritanceOperation: ea.InheritanceOperation,
+			DescendantsAction:    ea.DescendantsAction,
+		})
+		ret[name] = string(stringVal)
+	}
+	return ret, nil
+}
+
+// GetEADefinition returns the extensible attribute definition with the given name
+func (c *Client) GetEADefinition(name string) (EADefinition, error) {
+	err := c.GetEADefinitions(true)
+	if err != nil {
+		return EADefinition{}, err
+	}
+	if def, ok := c.cachedEADefinition(name); ok {
+		return def, nil
+	}
+	return EADefinition{}, fmt.Errorf("No ea definition found for ea: %s", name)
+}
+
+// cachedEADefinition returns the cached extensible attribute definition with
+// the given name
+func (c *Client) cachedEADefinition(name string) (EADefinition, bool) {
+	c.eaDefinitionsLock.RLock()
+	defer c.eaDefinitionsLock.RUnlock()
+
+	for _, def := range c.eaDefinitions {
+		if def.Name == name {
+			return def, true
+		}
+	}
+	return EADefinition{}, false
+}
+
+// PlanEADefinition records an extensible attribute definition that is going to
+// be created or changed, so values can be checked against it before it exists
+// on the grid
+func (c *Client) PlanEADefinition(definition EADefinition) {
+	c.eaDefinitionsLock.Lock()
+	defer c.eaDefinitionsLock.Unlock()
+
+	if c.plannedEADefinitions == nil {
+		c.plannedEADefinitions = make(map[string]EADefinition)
+	}
+	c.plannedEADefinitions[definition.Name] = definition
+}
+
+// PlannedEADefinition returns the planned extensible attribute definition with
+// the given name
+func (c *Client) PlannedEADefinition(name string) (EADefinition, bool) {
+	c.eaDefinitionsLock.RLock()
+	defer c.eaDefinitionsLock.RUnlock()
+
+	def, ok := c.plannedEADefinitions[name]
+	return def, ok
+}
+
+// ForgetPlannedEADefinition removes the planned extensible attribute
+// definition with the given name once it has been applied or destroyed, so
+// values are checked against the grid again
+func (c *Client) ForgetPlannedEADefinition(name string) {
+	c.eaDefinitionsLock.Lock()
+	defer c.eaDefinitionsLock.Unlock()
+
+	delete(c.plannedEADefinitions, name)
+}
+
+// GetEADefinitionByRef gets extensible attribute definition by reference
+func (c *Client) GetEADefinitionByRef(ref string, queryParams map[string]string) (EADefinition, error) {
+	var ret EADefinition
+	if queryParams == nil {
+		queryParams = map[string]string{
+			"_return_fields": eaDefinitionReturnFields,
+		}
+	} else {
+		queryParams["_return_fields"] = eaDefinitionReturnFields
+	}
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetEADefinitionByQuery gets extensible attribute definitions by query parameters
+func (c *Client) GetEADefinitionByQuery(queryParams map[string]string) ([]EADefinition, error) {
+	var ret []EADefinition
+	queryParams["_return_fields"] = eaDefinitionReturnFields
+	queryParams["_paging"] = "0"
+	queryParams["_max_results"] = "2"
+
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", eaDefintionBasePath, queryParamString), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return nil, fmt.Errorf(response.ErrorMessage)
+	}
+
+	return ret, nil
+}
+
+// CreateEADefinition creates extensible attribute definition and refreshes
+// the cached definitions
+func (c *Client) CreateEADefinition(definition *EADefinition) error {
+	queryParams := map[string]string{
+		"_return_fields": eaDefinitionReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", eaDefintionBasePath, queryParamString), definition)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &definition)
+	if response != nil {
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return c.GetEADefinitions(false)
+}
+
+// UpdateEADefinition updates extensible attribute definition fields and
+// refreshes the cached definitions. Fields are sent as given, so a nil value
+// unsets the field on the grid
+func (c *Client) UpdateEADefinition(ref string, fields map[string]interface{}) (EADefinition, error) {
+	var ret EADefinition
+	queryParams := map[string]string{
+		"_return_fields": eaDefinitionReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), fields)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, fmt.Errorf(response.ErrorMessage)
+	}
+	return ret, c.GetEADefinitions(false)
+}
+
+// DeleteEADefinition deletes extensible attribute definition and refreshes
+// the cached definitions
+func (c *Client) DeleteEADefinition(ref string) error {
+	request, err := c.CreateJSONRequest(http.MethodDelete, ref, nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return c.GetEADefinitions(false)
+}
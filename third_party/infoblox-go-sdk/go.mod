@@ -0,0 +1,5 @@
+module github.com/techBeck03/infoblox-go-sdk
+
+go 1.18
+
+require github.com/techBeck03/go-ipmath v0.0.8
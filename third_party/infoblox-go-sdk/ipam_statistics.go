@@ -0,0 +1,76 @@
+package infoblox
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	ipamStatisticsBasePath      = "ipam:statistics"
+	ipamStatisticsReturnFields  = "cidr,conflict_count,network,network_view,unmanaged_count,utilization"
+	rangeStatisticsReturnFields = "network,network_view,start_addr,end_addr,total_hosts,dynamic_hosts,static_hosts,dhcp_utilization"
+)
+
+// GetIPAMStatisticsByQuery gets ipam statistics of the networks and network
+// containers matching the query parameters
+func (c *Client) GetIPAMStatisticsByQuery(queryParams map[string]string) ([]IPAMStatistics, error) {
+	var ret []IPAMStatistics
+
+	queryParams["_return_fields"] = ipamStatisticsReturnFields
+
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ipamStatisticsBasePath, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, fmt.Errorf(response.ErrorMessage)
+	}
+
+	return ret, nil
+}
+
+// GetRangeStatisticsByRef gets DHCP statistics of range by reference
+func (c *Client) GetRangeStatisticsByRef(ref string) (RangeStatistics, error) {
+	var ret RangeStatistics
+
+	queryParams := map[string]string{
+		"_return_fields": rangeStatisticsReturnFields,
+	}
+
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetRangeStatisticsByQuery gets DHCP statistics of the ranges matching the
+// query parameters
+func (c *Client) GetRangeStatisticsByQuery(queryParams map[string]string) ([]RangeStatistics, error) {
+	var ret []RangeStatistics
+
+	queryParams["_return_fields"] = rangeStatisticsReturnFields
+
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", rangeBasePath, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, fmt.Errorf(response.ErrorMessage)
+	}
+
+	return ret, nil
+}
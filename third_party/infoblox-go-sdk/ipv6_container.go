@@ -0,0 +1,134 @@
+package infoblox
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	ipv6ContainerBasePath     = "ipv6networkcontainer"
+	ipv6ContainerReturnFields = "network,network_view,comment,extattrs"
+)
+
+// GetIPv6ContainerByRef gets IPv6 network container by reference
+func (c *Client) GetIPv6ContainerByRef(ref string, queryParams map[string]string) (IPv6NetworkContainer, error) {
+	var ret IPv6NetworkContainer
+	if queryParams == nil {
+		queryParams = map[string]string{
+			"_return_fields": ipv6ContainerReturnFields,
+		}
+	} else {
+		queryParams["_return_fields"] = ipv6ContainerReturnFields
+	}
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetIPv6ContainerByQuery gets IPv6 network containers by query parameters
+func (c *Client) GetIPv6ContainerByQuery(queryParams map[string]string) ([]IPv6NetworkContainer, error) {
+	var ret []IPv6NetworkContainer
+	queryParams["_return_fields"] = ipv6ContainerReturnFields
+	queryParams["_paging"] = "0"
+	queryParams["_max_results"] = "2"
+
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ipv6ContainerBasePath, queryParamString), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return nil, fmt.Errorf(response.ErrorMessage)
+	}
+
+	return ret, nil
+}
+
+// CreateIPv6Container creates IPv6 network container
+func (c *Client) CreateIPv6Container(container *IPv6NetworkContainer) error {
+	queryParams := map[string]string{
+		"_return_fields": ipv6ContainerReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", ipv6ContainerBasePath, queryParamString), container)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &container)
+	if response != nil {
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
+
+// CreateIPv6ContainerFromContainer creates IPv6 network container using the next available network of a container
+func (c *Client) CreateIPv6ContainerFromContainer(container *NetworkContainerFromContainer) (IPv6NetworkContainer, error) {
+	var ret IPv6NetworkContainer
+	queryParams := map[string]string{
+		"_return_fields":    ipv6ContainerReturnFields,
+		"_return_as_object": "1",
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", ipv6ContainerBasePath, queryParamString), container)
+	if err != nil {
+		return ret, err
+	}
+
+	var result NetworkFromContainerResult
+	response := c.Call(request, &result)
+	if response != nil {
+		return ret, fmt.Errorf(response.ErrorMessage)
+	}
+
+	return c.GetIPv6ContainerByRef(result.Result.Ref, nil)
+}
+
+// UpdateIPv6Container updates IPv6 network container
+func (c *Client) UpdateIPv6Container(ref string, container IPv6NetworkContainer) (IPv6NetworkContainer, error) {
+	var ret IPv6NetworkContainer
+	queryParams := map[string]string{
+		"_return_fields": ipv6ContainerReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), container)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, fmt.Errorf(response.ErrorMessage)
+	}
+	return ret, nil
+}
+
+// DeleteIPv6Container deletes IPv6 network container
+func (c *Client) DeleteIPv6Container(ref string) error {
+	request, err := c.CreateJSONRequest(http.MethodDelete, ref, nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
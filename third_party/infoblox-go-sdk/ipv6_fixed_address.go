@@ -0,0 +1,112 @@
+package infoblox
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	ipv6FixedAddressBasePath     = "ipv6fixedaddress"
+	ipv6FixedAddressReturnFields = "extattrs,ipv6addr,duid,ipv6prefix,ipv6prefix_bits,address_type,network_view,disable,comment,name,network,options"
+)
+
+// GetIPv6FixedAddressByRef gets IPv6 fixed address by reference
+func (c *Client) GetIPv6FixedAddressByRef(ref string, queryParams map[string]string) (IPv6FixedAddress, error) {
+	var ret IPv6FixedAddress
+	if queryParams == nil {
+		queryParams = map[string]string{
+			"_return_fields": ipv6FixedAddressReturnFields,
+		}
+	} else {
+		queryParams["_return_fields"] = ipv6FixedAddressReturnFields
+	}
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetIPv6FixedAddressByQuery gets IPv6 fixed addresss by query parameters
+func (c *Client) GetIPv6FixedAddressByQuery(queryParams map[string]string) ([]IPv6FixedAddress, error) {
+	var ret []IPv6FixedAddress
+	queryParams["_return_fields"] = ipv6FixedAddressReturnFields
+	queryParams["_paging"] = "0"
+	queryParams["_max_results"] = "2"
+
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ipv6FixedAddressBasePath, queryParamString), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return nil, fmt.Errorf(response.ErrorMessage)
+	}
+
+	return ret, nil
+}
+
+// CreateIPv6FixedAddress creates IPv6 fixed address
+func (c *Client) CreateIPv6FixedAddress(fixedAddress *IPv6FixedAddress) error {
+	queryParams := map[string]string{
+		"_return_fields": ipv6FixedAddressReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", ipv6FixedAddressBasePath, queryParamString), fixedAddress)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &fixedAddress)
+	if response != nil {
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
+
+// UpdateIPv6FixedAddress updates IPv6 fixed address
+func (c *Client) UpdateIPv6FixedAddress(ref string, fixedAddress IPv6FixedAddress) (IPv6FixedAddress, error) {
+	var ret IPv6FixedAddress
+	queryParams := map[string]string{
+		"_return_fields": ipv6FixedAddressReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), fixedAddress)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, fmt.Errorf(response.ErrorMessage)
+	}
+	return ret, nil
+}
+
+// DeleteIPv6FixedAddress deletes IPv6 fixed address
+func (c *Client) DeleteIPv6FixedAddress(ref string) error {
+	request, err := c.CreateJSONRequest(http.MethodDelete, ref, nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package infoblox
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	ipv6NetworkBasePath     = "ipv6network"
+	ipv6NetworkReturnFields = "network,network_view,comment,disable,extattrs,members,options"
+)
+
+// GetIPv6NetworkByRef gets IPv6 network by reference
+func (c *Client) GetIPv6NetworkByRef(ref string, queryParams map[string]string) (IPv6Network, error) {
+	var ret IPv6Network
+	if queryParams == nil {
+		queryParams = map[string]string{
+			"_return_fields": ipv6NetworkReturnFields,
+		}
+	} else {
+		queryParams["_return_fields"] = ipv6NetworkReturnFields
+	}
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetIPv6NetworkByQuery gets IPv6 networks by query parameters
+func (c *Client) GetIPv6NetworkByQuery(queryParams map[string]string) ([]IPv6Network, error) {
+	var ret []IPv6Network
+	queryParams["_return_fields"] = ipv6NetworkReturnFields
+	queryParams["_paging"] = "0"
+	queryParams["_max_results"] = "2"
+
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ipv6NetworkBasePath, queryParamString), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return nil, fmt.Errorf(response.ErrorMessage)
+	}
+
+	return ret, nil
+}
+
+// CreateIPv6Network creates IPv6 network
+func (c *Client) CreateIPv6Network(network *IPv6Network) error {
+	queryParams := map[string]string{
+		"_return_fields": ipv6NetworkReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", ipv6NetworkBasePath, queryParamString), network)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &network)
+	if response != nil {
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
+
+// CreateIPv6NetworkFromContainer creates IPv6 network using the next available network of a container
+func (c *Client) CreateIPv6NetworkFromContainer(network *NetworkFromContainer) (IPv6Network, error) {
+	var ret IPv6Network
+	queryParams := map[string]string{
+		"_return_fields":    ipv6NetworkReturnFields,
+		"_return_as_object": "1",
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", ipv6NetworkBasePath, queryParamString), network)
+	if err != nil {
+		return ret, err
+	}
+
+	var result NetworkFromContainerResult
+	response := c.Call(request, &result)
+	if response != nil {
+		return ret, fmt.Errorf(response.ErrorMessage)
+	}
+
+	return c.GetIPv6NetworkByRef(result.Result.Ref, nil)
+}
+
+// UpdateIPv6Network updates IPv6 network
+func (c *Client) UpdateIPv6Network(ref string, network IPv6Network) (IPv6Network, error) {
+	var ret IPv6Network
+	queryParams := map[string]string{
+		"_return_fields": ipv6NetworkReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), network)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, fmt.Errorf(response.ErrorMessage)
+	}
+	return ret, nil
+}
+
+// DeleteIPv6Network deletes IPv6 network
+func (c *Client) DeleteIPv6Network(ref string) error {
+	request, err := c.CreateJSONRequest(http.MethodDelete, ref, nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
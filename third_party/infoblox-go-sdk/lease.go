@@ -0,0 +1,45 @@
+package infoblox
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	leaseBasePath     = "lease"
+	leaseReturnFields = "address,binding_state,client_hostname,ends,hardware,network,network_view,served_by,server_host_name,starts"
+)
+
+// GetLeasesByQuery gets DHCP leases matching the query parameters, following
+// result pages until the last one is returned
+func (c *Client) GetLeasesByQuery(queryParams map[string]string) ([]Lease, error) {
+	ret := []Lease{}
+	queryParams["_return_fields"] = leaseReturnFields
+	queryParams["_return_as_object"] = "1"
+	queryParams["_paging"] = "1"
+	if _, ok := queryParams["_max_results"]; !ok {
+		queryParams["_max_results"] = "1000"
+	}
+
+	for {
+		var page LeaseQueryResult
+		queryParamString := c.BuildQuery(queryParams)
+		request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", leaseBasePath, queryParamString), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		response := c.Call(request, &page)
+		if response != nil {
+			return nil, fmt.Errorf(response.ErrorMessage)
+		}
+
+		ret = append(ret, page.Results...)
+		if page.NextPageID == "" {
+			break
+		}
+		queryParams["_page_id"] = page.NextPageID
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,112 @@
+package infoblox
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	mxRecordBasePath     = "record:mx"
+	mxRecordReturnFields = "comment,disable,dns_mail_exchanger,dns_name,extattrs,mail_exchanger,name,preference,ttl,use_ttl,view,zone"
+)
+
+// GetMXRecordByRef gets MX record by reference
+func (c *Client) GetMXRecordByRef(ref string, queryParams map[string]string) (MXRecord, error) {
+	var ret MXRecord
+	if queryParams == nil {
+		queryParams = map[string]string{
+			"_return_fields": mxRecordReturnFields,
+		}
+	} else {
+		queryParams["_return_fields"] = mxRecordReturnFields
+	}
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetMXRecordByQuery gets MX records by query parameters
+func (c *Client) GetMXRecordByQuery(queryParams map[string]string) ([]MXRecord, error) {
+	var ret []MXRecord
+	queryParams["_return_fields"] = mxRecordReturnFields
+	queryParams["_paging"] = "0"
+	queryParams["_max_results"] = "2"
+
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", mxRecordBasePath, queryParamString), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return nil, fmt.Errorf(response.ErrorMessage)
+	}
+
+	return ret, nil
+}
+
+// CreateMXRecord creates MX record
+func (c *Client) CreateMXRecord(record *MXRecord) error {
+	queryParams := map[string]string{
+		"_return_fields": mxRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", mxRecordBasePath, queryParamString), record)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &record)
+	if response != nil {
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
+
+// UpdateMXRecord updates MX record
+func (c *Client) UpdateMXRecord(ref string, record MXRecord) (MXRecord, error) {
+	var ret MXRecord
+	queryParams := map[string]string{
+		"_return_fields": mxRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), record)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, fmt.Errorf(response.ErrorMessage)
+	}
+	return ret, nil
+}
+
+// DeleteMXRecord deletes MX record
+func (c *Client) DeleteMXRecord(ref string) error {
+	request, err := c.CreateJSONRequest(http.MethodDelete, ref, nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package infoblox
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	networkViewBasePath     = "networkview"
+	networkViewReturnFields = "comment,extattrs,is_default,name"
+)
+
+// GetNetworkViewByRef gets network view by reference
+func (c *Client) GetNetworkViewByRef(ref string, queryParams map[string]string) (NetworkView, error) {
+	var ret NetworkView
+	if queryParams == nil {
+		queryParams = map[string]string{
+			"_return_fields": networkViewReturnFields,
+		}
+	} else {
+		queryParams["_return_fields"] = networkViewReturnFields
+	}
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetNetworkViewByQuery gets network views by query parameters
+func (c *Client) GetNetworkViewByQuery(queryParams map[string]string) ([]NetworkView, error) {
+	var ret []NetworkView
+	queryParams["_return_fields"] = networkViewReturnFields
+	queryParams["_paging"] = "0"
+	queryParams["_max_results"] = "2"
+
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", networkViewBasePath, queryParamString), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return nil, fmt.Errorf(response.ErrorMessage)
+	}
+
+	return ret, nil
+}
+
+// CreateNetworkView creates network view
+func (c *Client) CreateNetworkView(view *NetworkView) error {
+	queryParams := map[string]string{
+		"_return_fields": networkViewReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", networkViewBasePath, queryParamString), view)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &view)
+	if response != nil {
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
+
+// UpdateNetworkView updates network view
+func (c *Client) UpdateNetworkView(ref string, view NetworkView) (NetworkView, error) {
+	var ret NetworkView
+	queryParams := map[string]string{
+		"_return_fields": networkViewReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), view)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, fmt.Errorf(response.ErrorMessage)
+	}
+	return ret, nil
+}
+
+// DeleteNetworkView deletes network view
+func (c *Client) DeleteNetworkView(ref string) error {
+	request, err := c.CreateJSONRequest(http.MethodDelete, ref, nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
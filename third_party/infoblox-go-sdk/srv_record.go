@@ -0,0 +1,112 @@
+package infoblox
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	srvRecordBasePath     = "record:srv"
+	srvRecordReturnFields = "comment,disable,dns_name,dns_target,extattrs,name,port,priority,target,ttl,use_ttl,view,weight,zone"
+)
+
+// GetSRVRecordByRef gets SRV record by reference
+func (c *Client) GetSRVRecordByRef(ref string, queryParams map[string]string) (SRVRecord, error) {
+	var ret SRVRecord
+	if queryParams == nil {
+		queryParams = map[string]string{
+			"_return_fields": srvRecordReturnFields,
+		}
+	} else {
+		queryParams["_return_fields"] = srvRecordReturnFields
+	}
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetSRVRecordByQuery gets SRV records by query parameters
+func (c *Client) GetSRVRecordByQuery(queryParams map[string]string) ([]SRVRecord, error) {
+	var ret []SRVRecord
+	queryParams["_return_fields"] = srvRecordReturnFields
+	queryParams["_paging"] = "0"
+	queryParams["_max_results"] = "2"
+
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", srvRecordBasePath, queryParamString), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return nil, fmt.Errorf(response.ErrorMessage)
+	}
+
+	return ret, nil
+}
+
+// CreateSRVRecord creates SRV record
+func (c *Client) CreateSRVRecord(record *SRVRecord) error {
+	queryParams := map[string]string{
+		"_return_fields": srvRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", srvRecordBasePath, queryParamString), record)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &record)
+	if response != nil {
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
+
+// UpdateSRVRecord updates SRV record
+func (c *Client) UpdateSRVRecord(ref string, record SRVRecord) (SRVRecord, error) {
+	var ret SRVRecord
+	queryParams := map[string]string{
+		"_return_fields": srvRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), record)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, fmt.Errorf(response.ErrorMessage)
+	}
+	return ret, nil
+}
+
+// DeleteSRVRecord deletes SRV record
+func (c *Client) DeleteSRVRecord(ref string) error {
+	request, err := c.CreateJSONRequest(http.MethodDelete, ref, nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package infoblox
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	txtRecordBasePath     = "record:txt"
+	txtRecordReturnFields = "comment,disable,dns_name,extattrs,name,text,ttl,use_ttl,view,zone"
+)
+
+// GetTXTRecordByRef gets TXT record by reference
+func (c *Client) GetTXTRecordByRef(ref string, queryParams map[string]string) (TXTRecord, error) {
+	var ret TXTRecord
+	if queryParams == nil {
+		queryParams = map[string]string{
+			"_return_fields": txtRecordReturnFields,
+		}
+	} else {
+		queryParams["_return_fields"] = txtRecordReturnFields
+	}
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetTXTRecordByQuery gets TXT records by query parameters
+func (c *Client) GetTXTRecordByQuery(queryParams map[string]string) ([]TXTRecord, error) {
+	var ret []TXTRecord
+	queryParams["_return_fields"] = txtRecordReturnFields
+	queryParams["_paging"] = "0"
+	queryParams["_max_results"] = "2"
+
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", txtRecordBasePath, queryParamString), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return nil, fmt.Errorf(response.ErrorMessage)
+	}
+
+	return ret, nil
+}
+
+// CreateTXTRecord creates TXT record
+func (c *Client) CreateTXTRecord(record *TXTRecord) error {
+	queryParams := map[string]string{
+		"_return_fields": txtRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", txtRecordBasePath, queryParamString), record)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &record)
+	if response != nil {
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
+
+// UpdateTXTRecord updates TXT record
+func (c *Client) UpdateTXTRecord(ref string, record TXTRecord) (TXTRecord, error) {
+	var ret TXTRecord
+	queryParams := map[string]string{
+		"_return_fields": txtRecordReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), record)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, fmt.Errorf(response.ErrorMessage)
+	}
+	return ret, nil
+}
+
+// DeleteTXTRecord deletes TXT record
+func (c *Client) DeleteTXTRecord(ref string) error {
+	request, err := c.CreateJSONRequest(http.MethodDelete, ref, nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
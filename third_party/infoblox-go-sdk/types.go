@@ -0,0 +1,764 @@
+package infoblox
+
+// Grid defines grid properties
+type Grid struct {
+	Ref                string             `json:"_ref,omitempty"`
+	Name               string             `json:"name,omitempty"`
+	ServiceStatus      string             `json:"service_status,omitempty"`
+	DNSResolverSetting DNSResolverSetting `json:"dns_resolver_setting,omitempty"`
+}
+
+// DNSResolverSetting defines grid dns resolver configuration
+type DNSResolverSetting struct {
+	Resolvers     []string `json:"resolvers,omitempty"`
+	SearchDomains []string `json:"search_domains,omitempty"`
+}
+
+// GridMember defines grid member properties
+type GridMember struct {
+	Ref                      string `json:"_ref,omitempty"`
+	Hostname                 string `json:"host_name,omitempty"`
+	ConfigAddressType        string `json:"config_addr_type,omitempty"`
+	Platform                 string `json:"platform,omitempty"`
+	ServiceTypeConfiguration string `json:"service_type_configuration,omitempty"`
+}
+
+// GridServiceRestartRequest defines properties for grid restart request
+type GridServiceRestartRequest struct {
+	RestartOption string   `json:"restart_option,omitempty"`
+	Services      []string `json:"services,omitempty"`
+	Members       []string `json:"members,omitempty"`
+}
+
+// NextAvailableIPRequest defines properties for next_available_ip function calls
+type NextAvailableIPRequest struct {
+	Num     int      `json:"num,omitempty"`
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// NextAvailableIPResult object
+type NextAvailableIPResult struct {
+	IPs []string `json:"ips,omitempty"`
+}
+
+// ExtensibleAttribute extensible attribute object
+type ExtensibleAttribute map[string]ExtensibleAttributeValue
+
+// ExtensibleAttributeValue return value of ea
+type ExtensibleAttributeValue struct {
+	Value                interface{}        `json:"value,omitempty"`
+	InheritanceSource    *InheritanceSource `json:"inheritance_source,omitempty"`
+	InheritanceOperation string             `json:"inheritance_operation,omitempty"`
+	DescendantsAction    *DescendantsAction `json:"descendants_action,omitempty"`
+}
+
+// InheritanceSource defines inheritance of an EA
+type InheritanceSource struct {
+	Ref string `json:"_ref,omitempty"`
+}
+
+// DescendantsAction defines inheritance of an EA
+type DescendantsAction struct {
+	OptionDeleteEA  string `json:"option_delete_ea,omitempty"`
+	OptionWithEA    string `json:"option_with_ea,omitempty"`
+	OptionWithoutEA string `json:"option_without_ea,omitempty"`
+}
+
+// ExtensibleAttributeJSONMap ea object in terraform friendly JSON
+type ExtensibleAttributeJSONMap map[string]ExtensibleAttributeJSONMapValue
+
+// ExtensibleAttributeJSONMapValue value of ea in terraform friendly JSON
+type ExtensibleAttributeJSONMapValue struct {
+	Value                interface{}        `json:"value,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	InheritanceSource    *InheritanceSource `json:"inheritance_source,omitempty"`
+	InheritanceOperation string             `json:"inheritance_operation,omitempty"`
+	DescendantsAction    *DescendantsAction `json:"descendants_action,omitempty"`
+}
+
+// Network object
+type Network struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	NetworkView                string               `json:"network_view,omitempty"`
+	CIDR                       string               `json:"network,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	DisableDHCP                *bool                `json:"disable,omitempty"`
+	Members                    []Member             `json:"members,omitempty"`
+	Options                    []Option             `json:"options,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// NetworkContainer
+type NetworkContainer struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	NetworkView                string               `json:"network_view,omitempty"`
+	CIDR                       string               `json:"network,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// NetworkView object
+type NetworkView struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	Name                       string               `json:"name,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	IsDefault                  *bool                `json:"is_default,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// NetworkFromContainer object
+type NetworkFromContainer struct {
+	Ref                        string                   `json:"_ref,omitempty"`
+	NetworkView                string                   `json:"network_view,omitempty"`
+	Network                    NetworkContainerFunction `json:"network,omitempty"`
+	Comment                    string                   `json:"comment,omitempty"`
+	DisableDHCP                *bool                    `json:"disable,omitempty"`
+	Members                    []Member                 `json:"members,omitempty"`
+	Options                    []Option                 `json:"options,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute     `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute     `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute     `json:"extattrs-,omitempty"`
+}
+
+// NetworkContainerFromContainer object for a network container allocated from another container
+type NetworkContainerFromContainer struct {
+	Ref                  string                   `json:"_ref,omitempty"`
+	NetworkView          string                   `json:"network_view,omitempty"`
+	Network              NetworkContainerFunction `json:"network,omitempty"`
+	Comment              string                   `json:"comment,omitempty"`
+	ExtensibleAttributes *ExtensibleAttribute     `json:"extattrs,omitempty"`
+}
+
+// NetworkContainerFunction object
+type NetworkContainerFunction struct {
+	Function         string            `json:"_object_function,omitempty"`
+	ResultField      string            `json:"_result_field,omitempty"`
+	Object           string            `json:"_object,omitempty"`
+	ObjectParameters map[string]string `json:"_object_parameters,omitempty"`
+	Parameters       map[string]int    `json:"_parameters,omitempty"`
+}
+
+// NetworkFromContainerResult result object for network auto created by EA
+type NetworkFromContainerResult struct {
+	Result struct {
+		Ref     string `json:"_ref,omitempty"`
+		Network string `json:"network,omitempty"`
+	} `json:"result,omitempty"`
+}
+
+// IPv6Network object
+type IPv6Network struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	NetworkView                string               `json:"network_view,omitempty"`
+	CIDR                       string               `json:"network,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	Members                    *[]Member            `json:"members,omitempty"`
+	Options                    *[]Option            `json:"options,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// IPv6NetworkContainer object
+type IPv6NetworkContainer struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	NetworkView                string               `json:"network_view,omitempty"`
+	CIDR                       string               `json:"network,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// NetworkQueryResult object
+type NetworkQueryResult struct {
+	NextPageID string    `json:"next_page_id,omitempty"`
+	Results    []Network `json:"result,omitempty"`
+}
+
+// Member defines grid members
+type Member struct {
+	StructType  string `json:"_struct,omitempty"`
+	Hostname    string `json:"name,omitempty"`
+	IPV4Address string `json:"ipv4addr,omitempty"`
+	IPV6Address string `json:"ipv6addr,omitempty"`
+}
+
+// Option defines dhcp options
+type Option struct {
+	Name        string `json:"name,omitempty"`
+	Code        int    `json:"num,omitempty"`
+	UseOption   *bool  `json:"use_option,omitempty"`
+	Value       string `json:"value,omitempty"`
+	VendorClass string `json:"vendor_class,omitempty"`
+}
+
+// EADefinition extensible attribute definition
+type EADefinition struct {
+	Ref                string             `json:"_ref,omitempty"`
+	AllowedObjectTypes []string           `json:"allowed_object_types,omitempty"`
+	Comment            string             `json:"comment,omitempty"`
+	DefaultValue       string             `json:"default_value,omitempty"`
+	DescendantsAction  *DescendantsAction `json:"descendants_action,omitempty"`
+	Flags              string             `json:"flags,omitempty"`
+	ListValues         []ListValue        `json:"list_values,omitempty"`
+	Max                *int               `json:"max,omitempty"`
+	Min                *int               `json:"min,omitempty"`
+	Name               string             `json:"name,omitempty"`
+	Namespace          string             `json:"namespace,omitempty"`
+	Type               string             `json:"type,omitempty"`
+}
+
+// ListValue defines possible list values
+type ListValue struct {
+	Value string `json:"value,omitempty"`
+}
+
+// HostRecord object
+type HostRecord struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	Hostname                   string               `json:"name,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	EnableDNS                  *bool                `json:"configure_for_dns,omitempty"`
+	IPv4Addrs                  []IPv4Addr           `json:"ipv4addrs,omitempty"`
+	IPv6Addrs                  *[]IPv6Addr          `json:"ipv6addrs,omitempty"`
+	Aliases                    *[]string            `json:"aliases,omitempty"`
+	TTL                        *int                 `json:"ttl,omitempty"`
+	UseTTL                     *bool                `json:"use_ttl,omitempty"`
+	NetworkView                string               `json:"network_view,omitempty"`
+	RestartIfNeeded            *bool                `json:"restart_if_needed,omitempty"`
+	View                       string               `json:"view,omitempty"`
+	Zone                       string               `json:"zone,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// HostRecordQueryResult object
+type HostRecordQueryResult struct {
+	NextPageID string       `json:"next_page_id,omitempty"`
+	Results    []HostRecord `json:"result,omitempty"`
+}
+
+// IPv4Addr object
+type IPv4Addr struct {
+	Ref                 string                 `json:"_ref,omitempty"`
+	Host                string                 `json:"host,omitempty"`
+	IPAddress           string                 `json:"ipv4addr,omitempty"`
+	Mac                 string                 `json:"mac,omitempty"`
+	CIDR                string                 `json:"network,omitempty"`
+	ConfigureForDHCP    *bool                  `json:"configure_for_dhcp,omitempty"`
+	NextServer          string                 `json:"nextserver,omitempty"`
+	ObjectFunction      string                 `json:"_object_function,omitempty"`
+	UseForEAInheritance *bool                  `json:"use_for_ea_inheritance,omitempty"`
+	Parameters          map[string]interface{} `json:"_parameters,omitempty"`
+	ResultField         string                 `json:"_result_field,omitempty"`
+	Object              string                 `json:"_object,omitempty"`
+	ObjectParameters    map[string]interface{} `json:"_object_parameters,omitempty"`
+}
+
+// IPv6Addr object
+type IPv6Addr struct {
+	Ref              string `json:"_ref,omitempty"`
+	Host             string `json:"host,omitempty"`
+	IPAddress        string `json:"ipv6addr,omitempty"`
+	Duid             string `json:"duid,omitempty"`
+	CIDR             string `json:"network,omitempty"`
+	ConfigureForDHCP *bool  `json:"configure_for_dhcp,omitempty"`
+}
+
+// FixedAddress object
+type FixedAddress struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	NetworkView                string               `json:"network_view,omitempty"`
+	CIDR                       string               `json:"network,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	IPAddress                  string               `json:"ipv4addr,omitempty"`
+	Mac                        string               `json:"mac,omitempty"`
+	Hostname                   string               `json:"name,omitempty"`
+	MatchClient                string               `json:"match_client,omitempty"`
+	Options                    []Option             `json:"options,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// FixedAddressQueryResult object
+type FixedAddressQueryResult struct {
+	NextPageID string         `json:"next_page_id,omitempty"`
+	Results    []FixedAddress `json:"result,omitempty"`
+}
+
+// IPv4Address object
+type IPv4Address struct {
+	Ref         string   `json:"_ref,omitempty"`
+	Hostnames   []string `json:"names,omitempty"`
+	IPAddress   string   `json:"ip_address,omitempty"`
+	Mac         string   `json:"mac_address,omitempty"`
+	NetworkView string   `json:"network_view,omitempty"`
+	CIDR        string   `json:"network,omitempty"`
+	Usage       []string `json:"usage,omitempty"`
+	Types       []string `json:"types,omitempty"`
+	Objects     []string `json:"objects,omitempty"`
+	Status      string   `json:"status,omitempty"`
+	// Discovered and conflict data is only populated when explicitly requested
+	IsConflict     *bool           `json:"is_conflict,omitempty"`
+	ConflictTypes  []string        `json:"conflict_types,omitempty"`
+	DiscoveredData *DiscoveredData `json:"discovered_data,omitempty"`
+}
+
+// DiscoveredData object
+type DiscoveredData struct {
+	DeviceModel     string `json:"device_model,omitempty"`
+	DeviceType      string `json:"device_type,omitempty"`
+	DeviceVendor    string `json:"device_vendor,omitempty"`
+	Discoverer      string `json:"discoverer,omitempty"`
+	FirstDiscovered *int   `json:"first_discovered,omitempty"`
+	LastDiscovered  *int   `json:"last_discovered,omitempty"`
+	MacAddress      string `json:"mac_address,omitempty"`
+	NetBIOSName     string `json:"netbios_name,omitempty"`
+	OS              string `json:"os,omitempty"`
+}
+
+// AddressQueryResult object
+type AddressQueryResult struct {
+	NextPageID string        `json:"next_page_id,omitempty"`
+	Results    []IPv4Address `json:"result,omitempty"`
+}
+
+// AddressQuery object
+type AddressQuery struct {
+	NetworkView          string
+	FilterEmptyHostnames *bool
+	Retries              int
+	CIDR                 string
+	Count                int
+	StartAddress         string
+	EndAddress           string
+}
+
+func (aq *AddressQuery) fillDefaults() {
+	if aq.NetworkView == "" {
+		aq.NetworkView = "default"
+	}
+	if aq.Retries == 0 {
+		aq.Retries = 5
+	}
+	if aq.FilterEmptyHostnames == nil {
+		aq.FilterEmptyHostnames = newBool(false)
+	}
+}
+
+// Range object
+type Range struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	DisableDHCP                *bool                `json:"disable,omitempty"`
+	StartAddress               string               `json:"start_addr,omitempty"`
+	EndAddress                 string               `json:"end_addr,omitempty"`
+	NetworkView                string               `json:"network_view,omitempty"`
+	CIDR                       string               `json:"network,omitempty"`
+	Member                     *Member              `json:"member,omitempty"`
+	Options                    []Option             `json:"options,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+	IPAddressList              []string             `json:"ip_address_list,omitempty"`
+}
+
+// RangeQueryResult object
+type RangeQueryResult struct {
+	NextPageID string  `json:"next_page_id,omitempty"`
+	Results    []Range `json:"result,omitempty"`
+}
+
+// IPAMStatistics object
+type IPAMStatistics struct {
+	Ref            string `json:"_ref,omitempty"`
+	CIDR           *int   `json:"cidr,omitempty"`
+	Network        string `json:"network,omitempty"`
+	NetworkView    string `json:"network_view,omitempty"`
+	ConflictCount  *int   `json:"conflict_count,omitempty"`
+	UnmanagedCount *int   `json:"unmanaged_count,omitempty"`
+	// Utilization is expressed in tenths of a percent
+	Utilization *int `json:"utilization,omitempty"`
+}
+
+// RangeStatistics object
+type RangeStatistics struct {
+	Ref          string `json:"_ref,omitempty"`
+	StartAddress string `json:"start_addr,omitempty"`
+	EndAddress   string `json:"end_addr,omitempty"`
+	NetworkView  string `json:"network_view,omitempty"`
+	CIDR         string `json:"network,omitempty"`
+	TotalHosts   *int   `json:"total_hosts,omitempty"`
+	DynamicHosts *int   `json:"dynamic_hosts,omitempty"`
+	StaticHosts  *int   `json:"static_hosts,omitempty"`
+	// DHCPUtilization is expressed in tenths of a percent
+	DHCPUtilization *int `json:"dhcp_utilization,omitempty"`
+}
+
+// IPsWithinRangeQuery object
+type IPsWithinRangeQuery struct {
+	Ref          string
+	CIDR         string
+	StartAddress string
+	EndAddress   string
+}
+
+// ARecord object
+type ARecord struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	Hostname                   string               `json:"name,omitempty"`
+	DNSName                    string               `json:"dns_name,omitempty"`
+	IPAddress                  string               `json:"ipv4addr,omitempty"`
+	Zone                       string               `json:"zone,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	View                       string               `json:"view,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// ARecordQueryResult object
+type ARecordQueryResult struct {
+	NextPageID string    `json:"next_page_id,omitempty"`
+	Results    []ARecord `json:"result,omitempty"`
+}
+
+// AAAARecord object
+type AAAARecord struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	Hostname                   string               `json:"name,omitempty"`
+	DNSName                    string               `json:"dns_name,omitempty"`
+	IPAddress                  string               `json:"ipv6addr,omitempty"`
+	Zone                       string               `json:"zone,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	TTL                        *int                 `json:"ttl,omitempty"`
+	UseTTL                     *bool                `json:"use_ttl,omitempty"`
+	View                       string               `json:"view,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// MXRecord object
+type MXRecord struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	Name                       string               `json:"name,omitempty"`
+	DNSName                    string               `json:"dns_name,omitempty"`
+	MailExchanger              string               `json:"mail_exchanger,omitempty"`
+	DNSMailExchanger           string               `json:"dns_mail_exchanger,omitempty"`
+	Preference                 *int                 `json:"preference,omitempty"`
+	Zone                       string               `json:"zone,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	TTL                        *int                 `json:"ttl,omitempty"`
+	UseTTL                     *bool                `json:"use_ttl,omitempty"`
+	View                       string               `json:"view,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// TXTRecord object
+type TXTRecord struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	Name                       string               `json:"name,omitempty"`
+	DNSName                    string               `json:"dns_name,omitempty"`
+	Text                       string               `json:"text,omitempty"`
+	Zone                       string               `json:"zone,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	TTL                        *int                 `json:"ttl,omitempty"`
+	UseTTL                     *bool                `json:"use_ttl,omitempty"`
+	View                       string               `json:"view,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// SRVRecord object
+type SRVRecord struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	Name                       string               `json:"name,omitempty"`
+	DNSName                    string               `json:"dns_name,omitempty"`
+	Target                     string               `json:"target,omitempty"`
+	DNSTarget                  string               `json:"dns_target,omitempty"`
+	Port                       *int                 `json:"port,omitempty"`
+	Priority                   *int                 `json:"priority,omitempty"`
+	Weight                     *int                 `json:"weight,omitempty"`
+	Zone                       string               `json:"zone,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	TTL                        *int                 `json:"ttl,omitempty"`
+	UseTTL                     *bool                `json:"use_ttl,omitempty"`
+	View                       string               `json:"view,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// CNameRecord object
+type CNameRecord struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	Alias                      string               `json:"name,omitempty"`
+	Canonical                  string               `json:"canonical,omitempty"`
+	DNSName                    string               `json:"dns_name,omitempty"`
+	Zone                       string               `json:"zone,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	View                       string               `json:"view,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// CNameRecordQueryResult object
+type CNameRecordQueryResult struct {
+	NextPageID string        `json:"next_page_id,omitempty"`
+	Results    []CNameRecord `json:"result,omitempty"`
+}
+
+// AliasRecord object
+type AliasRecord struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	Name                       string               `json:"name,omitempty"`
+	Target                     string               `json:"target_name,omitempty"`
+	TargetType                 string               `json:"target_type,omitempty"`
+	DNSName                    string               `json:"dns_name,omitempty"`
+	DNSTargetName              string               `json:"dns_target_name,omitempty"`
+	Zone                       string               `json:"zone,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	View                       string               `json:"view,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// AliasRecordQueryResult object
+type AliasRecordQueryResult struct {
+	NextPageID string        `json:"next_page_id,omitempty"`
+	Results    []AliasRecord `json:"result,omitempty"`
+}
+
+// PtrRecord object
+type PtrRecord struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	Name                       string               `json:"name,omitempty"`
+	PointerDomainName          string               `json:"ptrdname,omitempty"`
+	IPv4Address                string               `json:"ipv4addr,omitempty"`
+	IPv6Address                string               `json:"ipv6addr,omitempty"`
+	DNSName                    string               `json:"dns_name,omitempty"`
+	DNSPointerDomainName       string               `json:"dns_ptrdname,omitempty"`
+	Zone                       string               `json:"zone,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	View                       string               `json:"view,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// PtrRecordQueryResult object
+type PtrRecordQueryResult struct {
+	NextPageID string      `json:"next_page_id,omitempty"`
+	Results    []PtrRecord `json:"result,omitempty"`
+}
+
+// ResponseError object
+type ResponseError struct {
+	StatusCode   int
+	Request      string
+	ResponseBody string
+	ErrorMessage string
+}
+
+// ZoneAuth object
+type ZoneAuth struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	FQDN                       string               `json:"fqdn,omitempty"`
+	DNSFQDN                    string               `json:"dns_fqdn,omitempty"`
+	View                       string               `json:"view,omitempty"`
+	ZoneFormat                 string               `json:"zone_format,omitempty"`
+	Comment                    *string              `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	GridPrimary                *[]MemberServer      `json:"grid_primary,omitempty"`
+	GridSecondaries            *[]MemberServer      `json:"grid_secondaries,omitempty"`
+	NSGroup                    *string              `json:"ns_group,omitempty"`
+	SOADefaultTTL              *int                 `json:"soa_default_ttl,omitempty"`
+	SOAExpire                  *int                 `json:"soa_expire,omitempty"`
+	SOANegativeTTL             *int                 `json:"soa_negative_ttl,omitempty"`
+	SOARefresh                 *int                 `json:"soa_refresh,omitempty"`
+	SOARetry                   *int                 `json:"soa_retry,omitempty"`
+	UseGridZoneTimer           *bool                `json:"use_grid_zone_timer,omitempty"`
+	SOAEmail                   string               `json:"soa_email,omitempty"`
+	UseSOAEmail                *bool                `json:"use_soa_email,omitempty"`
+	AllowTransfer              *[]AddressAC         `json:"allow_transfer,omitempty"`
+	UseAllowTransfer           *bool                `json:"use_allow_transfer,omitempty"`
+	AllowUpdate                *[]AddressAC         `json:"allow_update,omitempty"`
+	UseAllowUpdate             *bool                `json:"use_allow_update,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// MemberServer defines a grid member serving a zone
+type MemberServer struct {
+	Name          string `json:"name,omitempty"`
+	Stealth       *bool  `json:"stealth,omitempty"`
+	GridReplicate *bool  `json:"grid_replicate,omitempty"`
+	Lead          *bool  `json:"lead,omitempty"`
+}
+
+// AddressAC defines an address access control entry
+type AddressAC struct {
+	StructType string `json:"_struct,omitempty"`
+	Address    string `json:"address,omitempty"`
+	Permission string `json:"permission,omitempty"`
+}
+
+// ZoneDelegated is a delegated zone object
+type ZoneDelegated struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	FQDN                       string               `json:"fqdn,omitempty"`
+	DNSFQDN                    string               `json:"dns_fqdn,omitempty"`
+	View                       string               `json:"view,omitempty"`
+	ZoneFormat                 string               `json:"zone_format,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	DelegateTo                 *[]ExternalServer    `json:"delegate_to,omitempty"`
+	DelegatedTTL               *int                 `json:"delegated_ttl,omitempty"`
+	UseDelegatedTTL            *bool                `json:"use_delegated_ttl,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// ZoneForward is a forward zone object
+type ZoneForward struct {
+	Ref                        string                    `json:"_ref,omitempty"`
+	FQDN                       string                    `json:"fqdn,omitempty"`
+	DNSFQDN                    string                    `json:"dns_fqdn,omitempty"`
+	View                       string                    `json:"view,omitempty"`
+	ZoneFormat                 string                    `json:"zone_format,omitempty"`
+	Comment                    string                    `json:"comment,omitempty"`
+	Disable                    *bool                     `json:"disable,omitempty"`
+	ForwardTo                  *[]ExternalServer         `json:"forward_to,omitempty"`
+	ForwardersOnly             *bool                     `json:"forwarders_only,omitempty"`
+	ForwardingServers          *[]ForwardingMemberServer `json:"forwarding_servers,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute      `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute      `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute      `json:"extattrs-,omitempty"`
+}
+
+// ExternalServer defines a name server outside of the grid
+type ExternalServer struct {
+	Name    string `json:"name,omitempty"`
+	Address string `json:"address,omitempty"`
+}
+
+// ForwardingMemberServer defines a grid member forwarding queries for a zone
+type ForwardingMemberServer struct {
+	Name                  string            `json:"name,omitempty"`
+	ForwardersOnly        *bool             `json:"forwarders_only,omitempty"`
+	ForwardTo             *[]ExternalServer `json:"forward_to,omitempty"`
+	UseOverrideForwarders *bool             `json:"use_override_forwarders,omitempty"`
+}
+
+// DNSView is a DNS view object
+type DNSView struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	Name                       string               `json:"name,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	IsDefault                  *bool                `json:"is_default,omitempty"`
+	NetworkView                string               `json:"network_view,omitempty"`
+	Recursion                  *bool                `json:"recursion,omitempty"`
+	MatchClients               *[]AddressAC         `json:"match_clients,omitempty"`
+	MatchDestinations          *[]AddressAC         `json:"match_destinations,omitempty"`
+	Forwarders                 *[]string            `json:"forwarders,omitempty"`
+	ForwardOnly                *bool                `json:"forward_only,omitempty"`
+	UseForwarders              *bool                `json:"use_forwarders,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// IPv6FixedAddress is a DHCPv6 fixed address object
+type IPv6FixedAddress struct {
+	Ref                        string               `json:"_ref,omitempty"`
+	NetworkView                string               `json:"network_view,omitempty"`
+	CIDR                       string               `json:"network,omitempty"`
+	Comment                    string               `json:"comment,omitempty"`
+	Disable                    *bool                `json:"disable,omitempty"`
+	IPAddress                  string               `json:"ipv6addr,omitempty"`
+	Duid                       string               `json:"duid,omitempty"`
+	IPv6Prefix                 string               `json:"ipv6prefix,omitempty"`
+	IPv6PrefixBits             *int                 `json:"ipv6prefix_bits,omitempty"`
+	AddressType                string               `json:"address_type,omitempty"`
+	Hostname                   string               `json:"name,omitempty"`
+	Options                    []Option             `json:"options,omitempty"`
+	ExtensibleAttributes       *ExtensibleAttribute `json:"extattrs,omitempty"`
+	ExtensibleAttributesAdd    *ExtensibleAttribute `json:"extattrs+,omitempty"`
+	ExtensibleAttributesRemove *ExtensibleAttribute `json:"extattrs-,omitempty"`
+}
+
+// AllRecord is a record of any type returned by a zone wide allrecords search
+type AllRecord struct {
+	Ref     string      `json:"_ref,omitempty"`
+	Name    string      `json:"name,omitempty"`
+	Type    string      `json:"type,omitempty"`
+	View    string      `json:"view,omitempty"`
+	Zone    string      `json:"zone,omitempty"`
+	Comment string      `json:"comment,omitempty"`
+	Disable *bool       `json:"disable,omitempty"`
+	TTL     *int        `json:"ttl,omitempty"`
+	Address string      `json:"address,omitempty"`
+	Record  interface{} `json:"record,omitempty"`
+}
+
+// AllRecordQueryResult object
+type AllRecordQueryResult struct {
+	NextPageID string      `json:"next_page_id,omitempty"`
+	Results    []AllRecord `json:"result,omitempty"`
+}
+
+// WAPIObjectQueryResult object
+type WAPIObjectQueryResult struct {
+	NextPageID string                   `json:"next_page_id,omitempty"`
+	Results    []map[string]interface{} `json:"result,omitempty"`
+}
+
+// Lease object
+type Lease struct {
+	Ref            string `json:"_ref,omitempty"`
+	IPAddress      string `json:"address,omitempty"`
+	BindingState   string `json:"binding_state,omitempty"`
+	ClientHostname string `json:"client_hostname,omitempty"`
+	Mac            string `json:"hardware,omitempty"`
+	CIDR           string `json:"network,omitempty"`
+	NetworkView    string `json:"network_view,omitempty"`
+	ServedBy       string `json:"served_by,omitempty"`
+	ServerHostname string `json:"server_host_name,omitempty"`
+	// Starts and Ends are epoch timestamps
+	Starts *int `json:"starts,omitempty"`
+	Ends   *int `json:"ends,omitempty"`
+}
+
+// LeaseQueryResult object
+type LeaseQueryResult struct {
+	NextPageID string  `json:"next_page_id,omitempty"`
+	Results    []Lease `json:"result,omitempty"`
+}
@@ -0,0 +1,131 @@
+package infoblox
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GetWAPIObjectByRef gets an object of any WAPI object type by reference,
+// returning the object type's default fields when returnFields is empty
+func (c *Client) GetWAPIObjectByRef(ref string, returnFields []string) (map[string]interface{}, error) {
+	var ret map[string]interface{}
+
+	queryParams := map[string]string{}
+	if len(returnFields) > 0 {
+		queryParams["_return_fields"] = strings.Join(returnFields, ",")
+	}
+
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetWAPIObjectsByQuery gets objects of any WAPI object type matching the query
+// parameters. When paging is set result pages are followed until the last one
+// is returned
+func (c *Client) GetWAPIObjectsByQuery(objectType string, queryParams map[string]string, paging bool) ([]map[string]interface{}, error) {
+	ret := []map[string]interface{}{}
+
+	if !paging {
+		queryParamString := c.BuildQuery(queryParams)
+		request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", objectType, queryParamString), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		response := c.Call(request, &ret)
+		if response != nil {
+			return nil, fmt.Errorf(response.ErrorMessage)
+		}
+
+		return ret, nil
+	}
+
+	queryParams["_return_as_object"] = "1"
+	queryParams["_paging"] = "1"
+	if _, ok := queryParams["_max_results"]; !ok {
+		queryParams["_max_results"] = "1000"
+	}
+
+	for {
+		var page WAPIObjectQueryResult
+		queryParamString := c.BuildQuery(queryParams)
+		request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", objectType, queryParamString), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		response := c.Call(request, &page)
+		if response != nil {
+			return nil, fmt.Errorf(response.ErrorMessage)
+		}
+
+		ret = append(ret, page.Results...)
+		if page.NextPageID == "" {
+			break
+		}
+		queryParams["_page_id"] = page.NextPageID
+	}
+
+	return ret, nil
+}
+
+// CreateWAPIObject creates an object of any WAPI object type and returns its reference
+func (c *Client) CreateWAPIObject(objectType string, body map[string]interface{}) (string, error) {
+	var ref string
+
+	request, err := c.CreateJSONRequest(http.MethodPost, objectType, body)
+	if err != nil {
+		return ref, err
+	}
+
+	response := c.Call(request, &ref)
+	if response != nil {
+		return ref, fmt.Errorf(response.ErrorMessage)
+	}
+	return ref, nil
+}
+
+// UpdateWAPIObject updates an object of any WAPI object type and returns its
+// reference, which changes when a key field of the object is updated
+func (c *Client) UpdateWAPIObject(ref string, body map[string]interface{}) (string, error) {
+	var newRef string
+
+	request, err := c.CreateJSONRequest(http.MethodPut, ref, body)
+	if err != nil {
+		return newRef, err
+	}
+
+	response := c.Call(request, &newRef)
+	if response != nil {
+		return newRef, fmt.Errorf(response.ErrorMessage)
+	}
+	return newRef, nil
+}
+
+// DeleteWAPIObject deletes an object of any WAPI object type
+func (c *Client) DeleteWAPIObject(ref string) error {
+	request, err := c.CreateJSONRequest(http.MethodDelete, ref, nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if response.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
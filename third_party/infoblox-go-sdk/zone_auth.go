@@ -0,0 +1,112 @@
+package infoblox
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	zoneAuthBasePath     = "zone_auth"
+	zoneAuthReturnFields = "allow_transfer,allow_update,comment,disable,dns_fqdn,extattrs,fqdn,grid_primary,grid_secondaries,ns_group,soa_default_ttl,soa_email,soa_expire,soa_negative_ttl,soa_refresh,soa_retry,use_allow_transfer,use_allow_update,use_grid_zone_timer,use_soa_email,view,zone_format"
+)
+
+// GetZoneAuthByRef gets authoritative zone by reference
+func (c *Client) GetZoneAuthByRef(ref string, queryParams map[string]string) (ZoneAuth, error) {
+	var ret ZoneAuth
+	if queryParams == nil {
+		queryParams = map[string]string{
+			"_return_fields": zoneAuthReturnFields,
+		}
+	} else {
+		queryParams["_return_fields"] = zoneAuthReturnFields
+	}
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetZoneAuthByQuery gets authoritative zones by query parameters
+func (c *Client) GetZoneAuthByQuery(queryParams map[string]string) ([]ZoneAuth, error) {
+	var ret []ZoneAuth
+	queryParams["_return_fields"] = zoneAuthReturnFields
+	queryParams["_paging"] = "0"
+	queryParams["_max_results"] = "2"
+
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", zoneAuthBasePath, queryParamString), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return nil, fmt.Errorf(response.ErrorMessage)
+	}
+
+	return ret, nil
+}
+
+// CreateZoneAuth creates authoritative zone
+func (c *Client) CreateZoneAuth(zone *ZoneAuth) error {
+	queryParams := map[string]string{
+		"_return_fields": zoneAuthReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", zoneAuthBasePath, queryParamString), zone)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &zone)
+	if response != nil {
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
+
+// UpdateZoneAuth updates authoritative zone
+func (c *Client) UpdateZoneAuth(ref string, zone ZoneAuth) (ZoneAuth, error) {
+	var ret ZoneAuth
+	queryParams := map[string]string{
+		"_return_fields": zoneAuthReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), zone)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, fmt.Errorf(response.ErrorMessage)
+	}
+	return ret, nil
+}
+
+// DeleteZoneAuth deletes authoritative zone
+func (c *Client) DeleteZoneAuth(ref string) error {
+	request, err := c.CreateJSONRequest(http.MethodDelete, ref, nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
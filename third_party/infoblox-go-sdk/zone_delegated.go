@@ -0,0 +1,112 @@
+package infoblox
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	zoneDelegatedBasePath     = "zone_delegated"
+	zoneDelegatedReturnFields = "comment,delegate_to,delegated_ttl,disable,dns_fqdn,extattrs,fqdn,use_delegated_ttl,view,zone_format"
+)
+
+// GetZoneDelegatedByRef gets delegated zone by reference
+func (c *Client) GetZoneDelegatedByRef(ref string, queryParams map[string]string) (ZoneDelegated, error) {
+	var ret ZoneDelegated
+	if queryParams == nil {
+		queryParams = map[string]string{
+			"_return_fields": zoneDelegatedReturnFields,
+		}
+	} else {
+		queryParams["_return_fields"] = zoneDelegatedReturnFields
+	}
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetZoneDelegatedByQuery gets delegated zones by query parameters
+func (c *Client) GetZoneDelegatedByQuery(queryParams map[string]string) ([]ZoneDelegated, error) {
+	var ret []ZoneDelegated
+	queryParams["_return_fields"] = zoneDelegatedReturnFields
+	queryParams["_paging"] = "0"
+	queryParams["_max_results"] = "2"
+
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", zoneDelegatedBasePath, queryParamString), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return nil, fmt.Errorf(response.ErrorMessage)
+	}
+
+	return ret, nil
+}
+
+// CreateZoneDelegated creates delegated zone
+func (c *Client) CreateZoneDelegated(zone *ZoneDelegated) error {
+	queryParams := map[string]string{
+		"_return_fields": zoneDelegatedReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", zoneDelegatedBasePath, queryParamString), zone)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &zone)
+	if response != nil {
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
+
+// UpdateZoneDelegated updates delegated zone
+func (c *Client) UpdateZoneDelegated(ref string, zone ZoneDelegated) (ZoneDelegated, error) {
+	var ret ZoneDelegated
+	queryParams := map[string]string{
+		"_return_fields": zoneDelegatedReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), zone)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, fmt.Errorf(response.ErrorMessage)
+	}
+	return ret, nil
+}
+
+// DeleteZoneDelegated deletes delegated zone
+func (c *Client) DeleteZoneDelegated(ref string) error {
+	request, err := c.CreateJSONRequest(http.MethodDelete, ref, nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
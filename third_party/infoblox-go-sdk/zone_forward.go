@@ -0,0 +1,112 @@
+package infoblox
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	zoneForwardBasePath     = "zone_forward"
+	zoneForwardReturnFields = "comment,disable,dns_fqdn,extattrs,forward_to,forwarders_only,forwarding_servers,fqdn,view,zone_format"
+)
+
+// GetZoneForwardByRef gets forward zone by reference
+func (c *Client) GetZoneForwardByRef(ref string, queryParams map[string]string) (ZoneForward, error) {
+	var ret ZoneForward
+	if queryParams == nil {
+		queryParams = map[string]string{
+			"_return_fields": zoneForwardReturnFields,
+		}
+	} else {
+		queryParams["_return_fields"] = zoneForwardReturnFields
+	}
+
+	queryParamString := c.BuildQuery(queryParams)
+
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", ref, queryParamString), nil)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, response
+	}
+
+	return ret, nil
+}
+
+// GetZoneForwardByQuery gets forward zones by query parameters
+func (c *Client) GetZoneForwardByQuery(queryParams map[string]string) ([]ZoneForward, error) {
+	var ret []ZoneForward
+	queryParams["_return_fields"] = zoneForwardReturnFields
+	queryParams["_paging"] = "0"
+	queryParams["_max_results"] = "2"
+
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodGet, fmt.Sprintf("%s?%s", zoneForwardBasePath, queryParamString), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return nil, fmt.Errorf(response.ErrorMessage)
+	}
+
+	return ret, nil
+}
+
+// CreateZoneForward creates forward zone
+func (c *Client) CreateZoneForward(zone *ZoneForward) error {
+	queryParams := map[string]string{
+		"_return_fields": zoneForwardReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPost, fmt.Sprintf("%s?%s", zoneForwardBasePath, queryParamString), zone)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, &zone)
+	if response != nil {
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}
+
+// UpdateZoneForward updates forward zone
+func (c *Client) UpdateZoneForward(ref string, zone ZoneForward) (ZoneForward, error) {
+	var ret ZoneForward
+	queryParams := map[string]string{
+		"_return_fields": zoneForwardReturnFields,
+	}
+	queryParamString := c.BuildQuery(queryParams)
+	request, err := c.CreateJSONRequest(http.MethodPut, fmt.Sprintf("%s?%s", ref, queryParamString), zone)
+	if err != nil {
+		return ret, err
+	}
+
+	response := c.Call(request, &ret)
+	if response != nil {
+		return ret, fmt.Errorf(response.ErrorMessage)
+	}
+	return ret, nil
+}
+
+// DeleteZoneForward deletes forward zone
+func (c *Client) DeleteZoneForward(ref string) error {
+	request, err := c.CreateJSONRequest(http.MethodDelete, ref, nil)
+	if err != nil {
+		return err
+	}
+
+	response := c.Call(request, nil)
+	if response != nil {
+		if response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf(response.ErrorMessage)
+	}
+	return nil
+}